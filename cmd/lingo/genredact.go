@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/types"
+	"io"
+	"os"
+
+	"github.com/PriestFaria/lingo/internal/analyzer"
+	"github.com/PriestFaria/lingo/internal/config"
+	"github.com/PriestFaria/lingo/internal/filters"
+	"github.com/PriestFaria/lingo/internal/redact"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const genRedactUsage = `usage: lingo gen-redact [-zap] [-config file] [-o file] <package> <Type>...
+
+gen-redact prints a Go file declaring, for each named struct type, a
+LogValue method (or MarshalLogObject with -zap) that logs every field except
+the sensitive ones, which are logged as "[REDACTED]". Fields are classified
+as by the redact fix of the analyzer: a field is sensitive when it is tagged
+lingo:"secret" or is a debug_redact protobuf field, its name matches a
+security keyword, or its type is marked //lingo:sensitive, is in the
+sensitive type catalogue or holds such a field.
+`
+
+// genRedact runs the gen-redact subcommand with args, the command line after
+// its name.
+func genRedact(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("gen-redact", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprint(fs.Output(), genRedactUsage) }
+	zap := fs.Bool("zap", false, "generate MarshalLogObject for zap instead of LogValue for slog")
+	configPath := fs.String("config", "", "path to lingo config file (.lingo.json)")
+	out := fs.String("o", "", "write the generated file to `file` instead of standard output")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return fmt.Errorf("gen-redact: expected a package and at least one type")
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		return err
+	}
+	style := redact.Slog
+	if *zap {
+		style = redact.Zap
+	}
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.LoadAllSyntax}, fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("gen-redact: %w", err)
+	}
+	if len(pkgs) != 1 {
+		return fmt.Errorf("gen-redact: %q matches %d packages, want 1", fs.Arg(0), len(pkgs))
+	}
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return fmt.Errorf("gen-redact: %v", pkg.Errors[0])
+	}
+	marks, err := analyzer.Marks(pkg, cfg)
+	if err != nil {
+		return fmt.Errorf("gen-redact: %w", err)
+	}
+
+	security := &filters.SecurityFilter{
+		ExtraKeywords:  cfg.Security.ExtraKeywords,
+		SensitiveTypes: cfg.Security.SensitiveTypes,
+		IgnoreTypes:    cfg.Security.IgnoreTypes,
+		Marks:          marks,
+	}
+	redacted := security.RedactedFields(pkg.Types)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by lingo gen-redact. DO NOT EDIT.\n\npackage %s\n\nimport %q\n", pkg.Name, style.Import())
+	for _, name := range fs.Args()[1:] {
+		obj, ok := pkg.Types.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			return fmt.Errorf("gen-redact: %s.%s is not a type", pkg.PkgPath, name)
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok {
+			return fmt.Errorf("gen-redact: %s.%s is not a named type", pkg.PkgPath, name)
+		}
+		method, err := redact.Method(named, style, redacted)
+		if err != nil {
+			return fmt.Errorf("gen-redact: %w", err)
+		}
+		b.WriteString("\n" + method)
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("gen-redact: %w", err)
+	}
+	if *out == "" {
+		_, err = stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(*out, src, 0o644)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenRedact(t *testing.T) {
+	dir, err := filepath.Abs(filepath.Join("testdata", "genredact"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filepath.Join(dir, "account.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	// Account has a field of a //lingo:sensitive type and Profile a
+	// debug_redact protobuf field: both are redacted as by the redact fix.
+	var got bytes.Buffer
+	if err := genRedact([]string{"./account", "Account", "Profile"}, &got); err != nil {
+		t.Fatalf("gen-redact: %v", err)
+	}
+	if !bytes.Equal(got.Bytes(), want) {
+		t.Errorf("gen-redact output differs from account.golden:\n%s", got.String())
+	}
+}
+
+func TestGenRedact_NotAType(t *testing.T) {
+	t.Chdir(filepath.Join("testdata", "genredact"))
+
+	if err := genRedact([]string{"./account", "Missing"}, &bytes.Buffer{}); err == nil {
+		t.Error("expected an error for a name that is not a type, got nil")
+	}
+}
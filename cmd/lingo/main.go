@@ -1,21 +1,21 @@
 package main
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/PriestFaria/lingo/internal/analyzer"
 
 	"golang.org/x/tools/go/analysis/singlechecker"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "gen-redact" {
+		if err := genRedact(os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 	singlechecker.Main(analyzer.Analyzer)
 }
-
-
-
-
-
-
-
-
-
-
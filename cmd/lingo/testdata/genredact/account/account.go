@@ -0,0 +1,17 @@
+package account
+
+// Session is marked sensitive as a whole.
+//
+//lingo:sensitive
+type Session struct {
+	ID string
+}
+
+type Account struct {
+	ID       string
+	Email    string
+	Password string
+	Hint     string `lingo:"secret"`
+	Session  Session
+	Note     string
+}
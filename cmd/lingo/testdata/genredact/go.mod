@@ -0,0 +1,3 @@
+module example.com/genredact
+
+go 1.21
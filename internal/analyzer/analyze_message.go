@@ -1,11 +1,15 @@
 package analyzer
 
 import (
+	"fmt"
 	"go/ast"
+	"go/token"
 	"github.com/PriestFaria/lingo/internal/analyzer/log"
 	"github.com/PriestFaria/lingo/internal/config"
 	"github.com/PriestFaria/lingo/internal/filters"
+	"strconv"
 	"strings"
+	"sync"
 
 	"golang.org/x/tools/go/analysis"
 )
@@ -21,14 +25,38 @@ func buildFullText(parts []log.LogPart) string {
 
 // analyzeMessage builds a LogContext from parts, constructs the filter pipeline
 // according to cfg, and reports any issues found via pass.Report/pass.Reportf.
-func analyzeMessage(pass *analysis.Pass, callExpr *ast.CallExpr, parts []log.LogPart, cfg *config.Config) {
-	context := &log.LogContext{
-		Pass:     pass,
-		CallExpr: callExpr,
-		Parts:    parts,
-		FullText: buildFullText(parts),
+// level is the severity of the call, or empty when unknown.
+func analyzeMessage(pass *analysis.Pass, callExpr *ast.CallExpr, level string, parts []log.LogPart, cfg *config.Config) {
+	runFilters(pass, callExpr, level, parts, messageFilters(pass, cfg))
+}
+
+// analyzeCandidates runs the message filters over each possible form of a
+// message computed in SSA mode, reporting an issue found in several of them
+// only once.
+func analyzeCandidates(pass *analysis.Pass, callExpr *ast.CallExpr, level string, candidates [][]log.LogPart, cfg *config.Config) {
+	activeFilters := messageFilters(pass, cfg)
+	if len(activeFilters) == 0 {
+		return
+	}
+	type issueKey struct {
+		pos     token.Pos
+		message string
 	}
+	seen := map[issueKey]bool{}
+	for _, parts := range candidates {
+		for _, issue := range filterIssues(pass, callExpr, level, parts, activeFilters) {
+			key := issueKey{issue.Pos, issue.Message}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			reportIssue(pass, parts, issue)
+		}
+	}
+}
 
+// messageFilters returns the filters enabled in cfg for message text.
+func messageFilters(pass *analysis.Pass, cfg *config.Config) []filters.LogFilter {
 	var activeFilters []filters.LogFilter
 	if cfg.Filters.IsEnabled("first_letter") {
 		activeFilters = append(activeFilters, &filters.FirstLetterFilter{})
@@ -39,31 +67,195 @@ func analyzeMessage(pass *analysis.Pass, callExpr *ast.CallExpr, parts []log.Log
 	if cfg.Filters.IsEnabled("emoji") {
 		activeFilters = append(activeFilters, &filters.EmojiStrictFilter{})
 	}
-	if cfg.Filters.IsEnabled("security") {
-		activeFilters = append(activeFilters, &filters.SecurityFilter{
-			ExtraKeywords: cfg.Security.ExtraKeywords,
+	// The style rules leave messages of the skipped levels alone; the
+	// sensitive-data and injection checks apply at every level.
+	if len(cfg.Filters.SkipLevels) > 0 {
+		for i, f := range activeFilters {
+			activeFilters[i] = &filters.LevelFilter{Filter: f, SkipLevels: cfg.Filters.SkipLevels}
+		}
+	}
+	if cfg.Filters.IsEnabled("log_injection") {
+		activeFilters = append(activeFilters, &filters.LogInjectionFilter{Security: securityFilter(pass, cfg)})
+	}
+	return append(activeFilters, securityFilters(pass, cfg)...)
+}
+
+// analyzeAttributes runs only the sensitive-data checks over parts. It is used
+// for attribute keys and values, which are not subject to the message style rules.
+func analyzeAttributes(pass *analysis.Pass, callExpr *ast.CallExpr, parts []log.LogPart, cfg *config.Config) {
+	runFilters(pass, callExpr, "", parts, securityFilters(pass, cfg))
+}
+
+// securityFilters returns the sensitive-data filters enabled in cfg, for
+// credentials and for personal data, aware of the types and fields marked
+// sensitive in the packages seen by pass.
+func securityFilters(pass *analysis.Pass, cfg *config.Config) []filters.LogFilter {
+	var activeFilters []filters.LogFilter
+	if security := securityFilter(pass, cfg); security != nil {
+		activeFilters = append(activeFilters, security)
+	}
+	if cfg.Filters.IsEnabled("pii") {
+		activeFilters = append(activeFilters, &filters.PIIFilter{
+			ExtraKeywords:  cfg.PII.ExtraKeywords,
+			IgnoreKeywords: cfg.PII.IgnoreKeywords,
+			Patterns:       cfg.PII.Patterns,
+			Sanitizers:     cfg.Security.Sanitizers,
 		})
 	}
+	return activeFilters
+}
+
+// securityFilter returns the credentials filter configured by cfg, or nil
+// when it is disabled.
+func securityFilter(pass *analysis.Pass, cfg *config.Config) *filters.SecurityFilter {
+	if !cfg.Filters.IsEnabled("security") {
+		return nil
+	}
+	return &filters.SecurityFilter{
+		ExtraKeywords:    cfg.Security.ExtraKeywords,
+		LowConfidence:    cfg.Security.LowConfidence,
+		SensitiveTypes:   cfg.Security.SensitiveTypes,
+		IgnoreTypes:      cfg.Security.IgnoreTypes,
+		Sanitizers:       cfg.Security.Sanitizers,
+		Marks:            sensitiveMarks{pass: pass},
+		SecretPatterns:   cfg.Security.SecretPatterns,
+		EntropyThreshold: cfg.Security.EntropyThreshold,
+	}
+}
+
+// runFilters builds a LogContext from parts, runs activeFilters over it and
+// reports any issues found via pass.Report/pass.Reportf.
+func runFilters(pass *analysis.Pass, callExpr *ast.CallExpr, level string, parts []log.LogPart, activeFilters []filters.LogFilter) {
+	if len(activeFilters) == 0 {
+		return
+	}
+	for _, issue := range filterIssues(pass, callExpr, level, parts, activeFilters) {
+		reportIssue(pass, parts, issue)
+	}
+}
+
+// filterIssues builds a LogContext from parts and runs activeFilters over it.
+func filterIssues(pass *analysis.Pass, callExpr *ast.CallExpr, level string, parts []log.LogPart, activeFilters []filters.LogFilter) []filters.FilterIssue {
+	context := &log.LogContext{
+		Pass:     pass,
+		CallExpr: callExpr,
+		Level:    level,
+		Parts:    parts,
+		FullText: buildFullText(parts),
+	}
 
 	pipeline := filters.NewFilterPipeline(activeFilters)
+	return pipeline.Process(context)
+}
+
+// reportIssue reports issue, found in parts, with its suggested fix and a
+// pointer to the declaration it comes from, when known.
+func reportIssue(pass *analysis.Pass, parts []log.LogPart, issue filters.FilterIssue) {
+	related := relatedDeclaration(parts, issue.Pos)
+	if issue.Fix != nil && !offerFix(pass, issue.Fix) {
+		issue.Fix = nil
+	}
+	if issue.Fix != nil {
+		edits := []analysis.TextEdit{{
+			Pos:     issue.Fix.Pos,
+			End:     issue.Fix.End,
+			NewText: []byte(issue.Fix.NewText),
+		}}
+		pass.Report(analysis.Diagnostic{
+			Pos:     issue.Pos,
+			Message: issue.Message,
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message:   issue.Fix.Message,
+				TextEdits: append(edits, importEdits(pass, issue.Fix.Pos, issue.Fix.Imports)...),
+			}},
+			Related: related,
+		})
+	} else if related != nil {
+		pass.Report(analysis.Diagnostic{
+			Pos:     issue.Pos,
+			Message: issue.Message,
+			Related: related,
+		})
+	} else {
+		pass.Reportf(issue.Pos, "%s", issue.Message)
+	}
+}
+
+// offeredFix identifies the edit of a suggested fix.
+type offeredFix struct {
+	pos, end token.Pos
+	text     string
+}
+
+// offeredFixesByPass holds, for each running pass, the edits already offered
+// as suggested fixes.
+var offeredFixesByPass sync.Map
+
+// offerFix reports whether fix may be attached to a diagnostic: a fix whose
+// edit was already offered in the pass, such as the redacting method of a type
+// logged at several calls, is left out, so applying all fixes inserts it once.
+func offerFix(pass *analysis.Pass, fix *filters.IssueFix) bool {
+	offered, _ := offeredFixesByPass.LoadOrStore(pass, map[offeredFix]bool{})
+	key := offeredFix{pos: fix.Pos, end: fix.End, text: fix.NewText}
+	if offered.(map[offeredFix]bool)[key] {
+		return false
+	}
+	offered.(map[offeredFix]bool)[key] = true
+	return true
+}
+
+// importEdits returns the edits that add the imports paths to the file
+// containing pos, skipping those it already has.
+func importEdits(pass *analysis.Pass, pos token.Pos, paths []string) []analysis.TextEdit {
+	var file *ast.File
+	for _, f := range pass.Files {
+		if f.FileStart <= pos && pos <= f.FileEnd {
+			file = f
+		}
+	}
+	if file == nil {
+		return nil
+	}
+	var edits []analysis.TextEdit
+	for _, path := range paths {
+		if imported(file, path) {
+			continue
+		}
+		// Add to the first parenthesised import declaration, or declare the
+		// import right after the package clause.
+		edit := analysis.TextEdit{Pos: file.Name.End(), End: file.Name.End(), NewText: []byte(fmt.Sprintf("\n\nimport %q", path))}
+		for _, decl := range file.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT && gen.Lparen.IsValid() && len(gen.Specs) > 0 {
+				start := gen.Specs[0].Pos()
+				edit = analysis.TextEdit{Pos: start, End: start, NewText: []byte(fmt.Sprintf("%q\n\t", path))}
+				break
+			}
+		}
+		edits = append(edits, edit)
+	}
+	return edits
+}
+
+// imported reports whether file imports path.
+func imported(file *ast.File, path string) bool {
+	for _, spec := range file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err == nil && p == path {
+			return true
+		}
+	}
+	return false
+}
 
-	issues := pipeline.Process(context)
-	for _, issue := range issues {
-		if issue.Fix != nil {
-			pass.Report(analysis.Diagnostic{
-				Pos:     issue.Pos,
-				Message: issue.Message,
-				SuggestedFixes: []analysis.SuggestedFix{{
-					Message: issue.Fix.Message,
-					TextEdits: []analysis.TextEdit{{
-						Pos:     issue.Fix.Pos,
-						End:     issue.Fix.End,
-						NewText: []byte(issue.Fix.NewText),
-					}},
-				}},
-			})
-		} else {
-			pass.Reportf(issue.Pos, "%s", issue.Message)
+// relatedDeclaration points a diagnostic reported at a part resolved from a
+// constant or variable to the literal it was declared with.
+func relatedDeclaration(parts []log.LogPart, pos token.Pos) []analysis.RelatedInformation {
+	for _, part := range parts {
+		if part.Pos == pos && part.Decl.IsValid() {
+			return []analysis.RelatedInformation{{
+				Pos:     part.Decl,
+				Message: fmt.Sprintf("%s is declared here", part.Name),
+			}}
 		}
 	}
+	return nil
 }
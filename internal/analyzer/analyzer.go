@@ -22,7 +22,9 @@ var Analyzer *analysis.Analyzer = &analysis.Analyzer{
 	Run:  run,
 	Requires: []*analysis.Analyzer{
 		inspect.Analyzer,
+		literalsAnalyzer,
 	},
+	FactTypes: []analysis.Fact{new(wrapperFact), new(messageFact), new(sensitiveFact), new(redactingFact), new(taintFact), new(inputFact)},
 }
 
 // NewAnalyzerWithConfig creates a lingo analyzer pre-configured with cfg,
@@ -36,7 +38,9 @@ func NewAnalyzerWithConfig(cfg *config.Config) *analysis.Analyzer {
 		},
 		Requires: []*analysis.Analyzer{
 			inspect.Analyzer,
+			literalsAnalyzer,
 		},
+		FactTypes: []analysis.Fact{new(wrapperFact), new(messageFact), new(sensitiveFact), new(redactingFact), new(taintFact), new(inputFact)},
 	}
 }
 
@@ -49,8 +53,38 @@ func run(pass *analysis.Pass) (interface{}, error) {
 }
 
 // runWithConfig walks the AST of the package under analysis and routes
-// recognised log call expressions to the appropriate handler.
+// recognised log call expressions to the appropriate handler. Log wrappers
+// declared in the package are exported as facts first, so calls to them are
+// analysed here and in importing packages. Functions returning sensitive
+// values or untrusted input are exported as facts too, and in SSA mode the
+// possible values of string-returning functions as well.
 func runWithConfig(pass *analysis.Pass, cfg *config.Config) (interface{}, error) {
+	defer offeredFixesByPass.Delete(pass)
+	exportWrapperFacts(pass, cfg)
+	exportSensitiveFacts(pass, cfg)
+	checkFormatMethods(pass, cfg)
+	if cfg.Filters.IsEnabled("security") {
+		origins := newOrigins(pass, cfg, newSensitiveSources(cfg))
+		origins.exportFacts()
+		originsByPass.Store(pass, origins)
+		defer originsByPass.Delete(pass)
+	}
+	if cfg.Filters.IsEnabled("log_injection") {
+		inputs := newOrigins(pass, cfg, inputSources{})
+		inputs.exportFacts()
+		inputsByPass.Store(pass, inputs)
+		defer inputsByPass.Delete(pass)
+	}
+	if cfg.SSA {
+		if messages, err := newMessageValues(pass); err != nil {
+			warnSSAFallback(pass.Pkg.Path(), err)
+		} else {
+			messages.exportFacts()
+			messageValuesByPass.Store(pass, messages)
+			defer messageValuesByPass.Delete(pass)
+		}
+	}
+
 	inspector := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 	nodeFilter := []ast.Node{
 		(*ast.CallExpr)(nil),
@@ -62,29 +96,33 @@ func runWithConfig(pass *analysis.Pass, cfg *config.Config) (interface{}, error)
 			return
 		}
 
-		selectorExpression, ok := callExpession.Fun.(*ast.SelectorExpr)
+		pkgPath, fn, ok := resolveLogFunc(pass.TypesInfo, callExpession)
 		if !ok {
-			return
-		}
-
-		selection, ok := pass.TypesInfo.Selections[selectorExpression]
-		var pkgPath string
-
-		if ok {
-			pkgPath = selection.Obj().Pkg().Path()
-		} else if obj, ok := pass.TypesInfo.Uses[selectorExpression.Sel]; ok {
-			if pkg := obj.Pkg(); pkg != nil {
-				pkgPath = pkg.Path()
+			if fn, ok := resolveCustomLogFunc(pass.TypesInfo, callExpession, cfg.Loggers); ok {
+				handleCustom(pass, callExpession, fn, cfg)
+			} else if fn, ok := resolveInterfaceLogFunc(pass, callExpession); ok {
+				handleCustom(pass, callExpession, fn, cfg)
+			} else if fn, ok := resolveWrapper(pass, callExpession); ok {
+				handleCall(pass, callExpession, fn, cfg)
 			}
+			return
 		}
 
 		switch pkgPath {
 		case "go.uber.org/zap":
-			handleZap(pass, callExpession, cfg)
+			handleZap(pass, callExpession, fn, cfg)
 		case "log/slog":
-			handleSlog(pass, callExpession, cfg)
+			handleSlog(pass, callExpession, fn, cfg)
 		case "log":
-			handleLog(pass, callExpession, cfg)
+			handleLog(pass, callExpession, fn, cfg)
+		case "github.com/sirupsen/logrus":
+			handleLogrus(pass, callExpession, fn, cfg)
+		case "github.com/rs/zerolog", "github.com/rs/zerolog/log":
+			handleZerolog(pass, callExpession, fn, cfg)
+		case "github.com/go-logr/logr", "k8s.io/klog/v2", "github.com/hashicorp/go-hclog":
+			handleKeyValueCall(pass, callExpession, fn, cfg)
+		case "github.com/go-kit/log", "github.com/go-kit/kit/log":
+			handleGoKit(pass, callExpession, fn, cfg)
 		}
 	})
 	return nil, nil
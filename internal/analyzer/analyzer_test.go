@@ -2,6 +2,7 @@ package analyzer_test
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/PriestFaria/lingo/internal/analyzer"
@@ -11,7 +12,7 @@ import (
 
 func TestAnalyzer(t *testing.T) {
 	testdata := analysistest.TestData()
-	analysistest.Run(t, testdata, analyzer.Analyzer, "basic", "withzap", "clean", "concat", "realworld")
+	analysistest.Run(t, testdata, analyzer.Analyzer, "basic", "withzap", "clean", "concat", "realworld", "signatures", "slogattrs", "zapfields", "withlogrus", "withzerolog", "withlogr", "withklog", "withhclog", "withgokit", "example.com/applog", "withwrappers", "withifaces", "exprs", "formatted", "printf", "sensitivetypes", "withmarks/model", "withmarks", "withproto/userpb", "withproto", "taint/secrets", "taint", "secretlits")
 }
 
 func TestAnalyzerWithConfig(t *testing.T) {
@@ -27,3 +28,100 @@ func TestAnalyzerWithConfig(t *testing.T) {
 
 	analysistest.Run(t, testdata, analyzer.Analyzer, "withconfig")
 }
+
+func TestAnalyzerCustomLoggers(t *testing.T) {
+	testdata := analysistest.TestData()
+	configFile := filepath.Join(testdata, "src", "withloggers", ".lingo.json")
+
+	if err := analyzer.Analyzer.Flags.Set("config", configFile); err != nil {
+		t.Fatalf("failed to set config flag: %v", err)
+	}
+	t.Cleanup(func() {
+		analyzer.Analyzer.Flags.Set("config", "") //nolint:errcheck
+	})
+
+	analysistest.Run(t, testdata, analyzer.Analyzer, "withloggers")
+}
+
+func TestAnalyzerResolvedMessages(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.Analyzer, "consts")
+}
+
+func TestAnalyzerSSA(t *testing.T) {
+	testdata := analysistest.TestData()
+	configFile := filepath.Join(testdata, "src", "withssa", ".lingo.json")
+
+	if err := analyzer.Analyzer.Flags.Set("config", configFile); err != nil {
+		t.Fatalf("failed to set config flag: %v", err)
+	}
+	t.Cleanup(func() {
+		analyzer.Analyzer.Flags.Set("config", "") //nolint:errcheck
+	})
+
+	analysistest.Run(t, testdata, analyzer.Analyzer, "withssa/messages", "withssa")
+}
+
+func TestAnalyzerFormatMethods(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.Analyzer, "formatters")
+}
+
+func TestAnalyzerRedactFix(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.Analyzer, "redactfix", "redactfix/zapuser")
+}
+
+// TestAnalyzerRedactFix_OncePerType checks that a type logged at several calls
+// gets its redacting method offered once, so applying every fix does not
+// declare the method twice.
+func TestAnalyzerRedactFix_OncePerType(t *testing.T) {
+	testdata := analysistest.TestData()
+	results := analysistest.Run(t, testdata, analyzer.Analyzer, "redactfix")
+	var fixes int
+	for _, result := range results {
+		for _, diag := range result.Diagnostics {
+			for _, fix := range diag.SuggestedFixes {
+				if strings.HasSuffix(fix.Message, "method for User") {
+					fixes++
+				}
+			}
+		}
+	}
+	if fixes != 1 {
+		t.Errorf("got %d redact fixes for User, want 1", fixes)
+	}
+}
+
+func TestAnalyzerSanitizers(t *testing.T) {
+	testdata := analysistest.TestData()
+	configFile := filepath.Join(testdata, "src", "withsanitizers", ".lingo.json")
+
+	if err := analyzer.Analyzer.Flags.Set("config", configFile); err != nil {
+		t.Fatalf("failed to set config flag: %v", err)
+	}
+	t.Cleanup(func() {
+		analyzer.Analyzer.Flags.Set("config", "") //nolint:errcheck
+	})
+
+	analysistest.Run(t, testdata, analyzer.Analyzer, "withsanitizers")
+}
+
+func TestAnalyzerLogInjection(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.Analyzer, "injection")
+}
+
+func TestAnalyzerPII(t *testing.T) {
+	testdata := analysistest.TestData()
+	configFile := filepath.Join(testdata, "src", "pii", ".lingo.json")
+
+	if err := analyzer.Analyzer.Flags.Set("config", configFile); err != nil {
+		t.Fatalf("failed to set config flag: %v", err)
+	}
+	t.Cleanup(func() {
+		analyzer.Analyzer.Flags.Set("config", "") //nolint:errcheck
+	})
+
+	analysistest.Run(t, testdata, analyzer.Analyzer, "pii")
+}
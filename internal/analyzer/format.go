@@ -0,0 +1,90 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// formatParts collects the parts of a printf-style call: the format string
+// followed by the parts of each operand. When the format string is known text
+// and the operands are listed individually, its verbs are paired with the
+// operands they format (see log.FormatVerb).
+func formatParts(format ast.Expr, operands []ast.Expr, spread bool, pass *analysis.Pass) []log.LogPart {
+	parts := collectPartsFromExpr(format, pass)
+	if len(parts) == 1 && parts[0].IsLiteral && !spread {
+		parts[0].Verbs = formatVerbs(parts[0].Value, operands)
+	}
+	return append(parts, collectArgsParts(operands, pass)...)
+}
+
+// formatVerbs parses the verbs of a printf format string, following the fmt
+// rules for explicit argument indexes ("%[2]s") and for '*' widths and
+// precisions, which consume an operand of their own.
+func formatVerbs(format string, operands []ast.Expr) []log.FormatVerb {
+	var verbs []log.FormatVerb
+	argNum := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		start := i
+		i++
+		for i < len(format) && strings.IndexByte("+-# 0", format[i]) >= 0 {
+			i++
+		}
+		argIndex := func() {
+			if i >= len(format) || format[i] != '[' {
+				return
+			}
+			end := strings.IndexByte(format[i:], ']')
+			if end < 0 {
+				return
+			}
+			if n, err := strconv.Atoi(format[i+1 : i+end]); err == nil && n > 0 {
+				argNum = n - 1
+			}
+			i += end + 1
+		}
+		number := func() {
+			argIndex()
+			if i < len(format) && format[i] == '*' {
+				argNum++
+				i++
+				return
+			}
+			for i < len(format) && format[i] >= '0' && format[i] <= '9' {
+				i++
+			}
+		}
+		number()
+		if i < len(format) && format[i] == '.' {
+			i++
+			number()
+		}
+		argIndex()
+		if i >= len(format) {
+			break
+		}
+		verb, size := utf8.DecodeRuneInString(format[i:])
+		i += size - 1
+		if verb == '%' {
+			continue
+		}
+		v := log.FormatVerb{Verb: format[start : i+1], Offset: start}
+		if argNum < len(operands) {
+			v.Arg = types.ExprString(operands[argNum])
+			v.Pos = operands[argNum].Pos()
+			v.End = operands[argNum].End()
+		}
+		verbs = append(verbs, v)
+		argNum++
+	}
+	return verbs
+}
@@ -0,0 +1,189 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"github.com/PriestFaria/lingo/internal/config"
+	"github.com/PriestFaria/lingo/internal/filters"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// formatMethods are the methods through which fmt, slog and zap render a
+// value in a log record.
+var formatMethods = []string{"String", "GoString", "LogValue", "MarshalLogObject"}
+
+// redactionPlaceholder replaces a sensitive field in the suggested fix.
+const redactionPlaceholder = `"[REDACTED]"`
+
+// checkFormatMethods reports the fields of its receiver that a formatting
+// method of the package under analysis references when their names match a
+// sensitive keyword or they are marked sensitive, unless they are only passed
+// to a sanitizer. Where a string can take the field's place in every rendered
+// use of the field, a fix replaces it with a redaction placeholder; a field
+// also compared, addressed or assigned in the method gets no fix.
+func checkFormatMethods(pass *analysis.Pass, cfg *config.Config) {
+	if !cfg.Filters.IsEnabled("security") {
+		return
+	}
+	security := &filters.SecurityFilter{ExtraKeywords: cfg.Security.ExtraKeywords, Sanitizers: cfg.Security.Sanitizers}
+	marks := sensitiveMarks{pass: pass}
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || fd.Body == nil || !slices.Contains(formatMethods, fd.Name.Name) {
+				continue
+			}
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+			recv := derefType(fn.Type().(*types.Signature).Recv().Type())
+			interfaceArgs := interfaceArgs(pass.TypesInfo, fd.Body)
+			parents := parentExprs(fd.Body)
+
+			var refs []fieldRef
+			// kept records the fields with a use the fix cannot rewrite.
+			kept := map[*types.Var]bool{}
+			ast.Inspect(fd.Body, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok && security.SanitizerCall(pass.TypesInfo, call) {
+					return false
+				}
+				se, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				sel := pass.TypesInfo.Selections[se]
+				if sel == nil || sel.Kind() != types.FieldVal || !types.Identical(derefType(sel.Recv()), recv) {
+					return true
+				}
+				field := sel.Obj().(*types.Var)
+				var reason string
+				if kw, ok := security.MatchName(field.Name()); ok {
+					reason = fmt.Sprintf("matches keyword %q", kw)
+				} else if marks.Sensitive(field) {
+					reason = "is marked sensitive"
+				} else {
+					return true
+				}
+
+				diag := analysis.Diagnostic{
+					Pos:     se.Pos(),
+					End:     se.End(),
+					Message: fmt.Sprintf("%s method may expose sensitive data: field %s %s", fd.Name.Name, field.Name(), reason),
+				}
+				fixable := (isStringType(field.Type()) || interfaceArgs[se]) && renderedValue(se, parents)
+				if !fixable {
+					kept[field] = true
+				}
+				refs = append(refs, fieldRef{diag: diag, field: field, fixable: fixable})
+				return false
+			})
+
+			for _, ref := range refs {
+				diag := ref.diag
+				if ref.fixable && !kept[ref.field] {
+					diag.SuggestedFixes = []analysis.SuggestedFix{{
+						Message: fmt.Sprintf("replace %s with a redaction placeholder", ref.field.Name()),
+						TextEdits: []analysis.TextEdit{{
+							Pos:     diag.Pos,
+							End:     diag.End,
+							NewText: []byte(redactionPlaceholder),
+						}},
+					}}
+				}
+				pass.Report(diag)
+			}
+		}
+	}
+}
+
+// fieldRef is a reported reference to a sensitive field of the receiver of a
+// formatting method.
+type fieldRef struct {
+	diag    analysis.Diagnostic
+	field   *types.Var
+	fixable bool
+}
+
+// parentExprs maps each node in body to its parent node.
+func parentExprs(body *ast.BlockStmt) map[ast.Node]ast.Node {
+	parents := map[ast.Node]ast.Node{}
+	var stack []ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		if len(stack) > 0 {
+			parents[n] = stack[len(stack)-1]
+		}
+		stack = append(stack, n)
+		return true
+	})
+	return parents
+}
+
+// renderedValue reports whether expr only contributes its value to what a
+// formatting method renders: it is a call argument or a returned result,
+// possibly through string concatenation. Comparisons, address-of operations
+// and assignments are not, and replacing the field there changes the method.
+func renderedValue(expr ast.Expr, parents map[ast.Node]ast.Node) bool {
+	switch p := parents[expr].(type) {
+	case *ast.ParenExpr:
+		return renderedValue(p, parents)
+	case *ast.BinaryExpr:
+		return p.Op == token.ADD && renderedValue(p, parents)
+	case *ast.CallExpr:
+		return slices.Contains(p.Args, expr)
+	case *ast.ReturnStmt:
+		return true
+	}
+	return false
+}
+
+// interfaceArgs returns the call arguments in body that are passed as
+// interface values, e.g. the operands of fmt.Sprintf.
+func interfaceArgs(info *types.Info, body *ast.BlockStmt) map[ast.Expr]bool {
+	args := map[ast.Expr]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sig, ok := info.TypeOf(call.Fun).(*types.Signature)
+		if !ok {
+			return true
+		}
+		params := sig.Params()
+		for i, arg := range call.Args {
+			var t types.Type
+			switch {
+			case sig.Variadic() && i >= params.Len()-1 && !call.Ellipsis.IsValid():
+				t = params.At(params.Len() - 1).Type().(*types.Slice).Elem()
+			case i < params.Len():
+				t = params.At(i).Type()
+			default:
+				continue
+			}
+			if types.IsInterface(t) {
+				args[ast.Unparen(arg)] = true
+			}
+		}
+		return true
+	})
+	return args
+}
+
+// derefType returns the element type of a pointer, or t itself.
+func derefType(t types.Type) types.Type {
+	if ptr, ok := t.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+	return t
+}
@@ -2,6 +2,7 @@ package analyzer
 
 import (
 	"go/ast"
+	"go/constant"
 	"go/token"
 	"go/types"
 	"strconv"
@@ -11,19 +12,38 @@ import (
 	"github.com/PriestFaria/lingo/internal/config"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
 )
 
 // collectPartsFromExpr recursively decomposes an AST expression into LogParts.
-// It handles string concatenation (BinaryExpr with ADD), string literals
-// (BasicLit), and identifiers (Ident).
-func collectPartsFromExpr(expr ast.Expr, info *types.Info) []log.LogPart {
+// String concatenation (BinaryExpr with ADD) is split into its operands and
+// string literals (BasicLit) become literal parts. Identifiers and qualified
+// names of string constants, and locals assigned exactly once from a literal,
+// become literal parts carrying the resolved text.
+//
+// Every other value becomes a non-literal part named after its source: the
+// identifier, the full selector path (cfg.Password), the index expression
+// (headers["Authorization"]), the method call (u.GetSecret()) or the composite
+// literal (request{Token: t}), so the sensitive-data checks can match field and
+// getter names. Formatting helpers are transparent (see formattedParts).
+// Parentheses, conversions such as string(b), dereferences, address operators,
+// slices and type assertions are looked through, and operands of other binary
+// expressions contribute their names only.
+func collectPartsFromExpr(expr ast.Expr, pass *analysis.Pass) []log.LogPart {
+	if part, ok := resolvedPart(expr, pass); ok {
+		return []log.LogPart{part}
+	}
 	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return collectPartsFromExpr(e.X, pass)
 	case *ast.BinaryExpr:
-		if e.Op == token.ADD {
-			left := collectPartsFromExpr(e.X, info)
-			right := collectPartsFromExpr(e.Y, info)
-			return append(left, right...)
+		left := collectPartsFromExpr(e.X, pass)
+		right := collectPartsFromExpr(e.Y, pass)
+		parts := append(left, right...)
+		if e.Op != token.ADD {
+			parts = namedParts(parts)
 		}
+		return parts
 	case *ast.BasicLit:
 		if e.Kind == token.STRING {
 			value, err := strconv.Unquote(e.Value)
@@ -37,71 +57,500 @@ func collectPartsFromExpr(expr ast.Expr, info *types.Info) []log.LogPart {
 				End:       e.End(),
 			}}
 		}
-	case *ast.Ident:
-		return []log.LogPart{{
-			Value:     e.Name,
-			IsLiteral: false,
-			Pos:       e.Pos(),
-			End:       e.End(),
-		}}
+	case *ast.StarExpr:
+		return collectPartsFromExpr(e.X, pass)
+	case *ast.SliceExpr:
+		return collectPartsFromExpr(e.X, pass)
+	case *ast.TypeAssertExpr:
+		return collectPartsFromExpr(e.X, pass)
+	case *ast.CallExpr:
+		if tv, ok := pass.TypesInfo.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return collectPartsFromExpr(e.Args[0], pass)
+		}
+		if parts, ok := formattedParts(e, pass); ok {
+			return parts
+		}
+		return []log.LogPart{namedPart(e, pass)}
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			return collectPartsFromExpr(e.X, pass)
+		}
+	case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr, *ast.CompositeLit:
+		return []log.LogPart{namedPart(e, pass)}
 	}
 	return nil
 }
 
-// isFormatMethod reports whether name is a format-style method (e.g. Printf,
-// Infof) by checking for a trailing "f" suffix (case-insensitive).
-func isFormatMethod(name string) bool {
-	return strings.HasSuffix(strings.ToLower(name), "f")
-}
+// formattedParts decomposes a call to a string-building helper into the parts
+// of its arguments: fmt.Sprintf, fmt.Sprint and fmt.Sprintln, the Error method
+// of errors.New or fmt.Errorf, and strings.Join, whose elements are
+// interleaved with the separator when the slice is a composite literal.
+func formattedParts(call *ast.CallExpr, pass *analysis.Pass) ([]log.LogPart, bool) {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Error" && len(call.Args) == 0 {
+		if inner, ok := ast.Unparen(sel.X).(*ast.CallExpr); ok {
+			if isFunc(pass.TypesInfo, inner, "errors", "New") {
+				return collectArgsParts(inner.Args, pass), true
+			}
+			if isFunc(pass.TypesInfo, inner, "fmt", "Errorf") && len(inner.Args) > 0 {
+				return formatParts(inner.Args[0], inner.Args[1:], inner.Ellipsis.IsValid(), pass), true
+			}
+		}
+		return nil, false
+	}
 
-// collectArgs extracts LogParts from a call expression.
-// For format methods the format string (Args[0]) and all value arguments are
-// collected; for regular methods only the first argument is used.
-func collectArgs(callExpr *ast.CallExpr, info *types.Info) []log.LogPart {
-	if len(callExpr.Args) == 0 {
-		return nil
+	switch {
+	case isFunc(pass.TypesInfo, call, "fmt", "Sprintf") && len(call.Args) > 0:
+		return formatParts(call.Args[0], call.Args[1:], call.Ellipsis.IsValid(), pass), true
+	case isFunc(pass.TypesInfo, call, "fmt", "Sprint"),
+		isFunc(pass.TypesInfo, call, "fmt", "Sprintln"):
+		return collectArgsParts(call.Args, pass), true
+	case isFunc(pass.TypesInfo, call, "strings", "Join") && len(call.Args) == 2:
+		lit, ok := ast.Unparen(call.Args[0]).(*ast.CompositeLit)
+		if !ok {
+			return collectPartsFromExpr(call.Args[0], pass), true
+		}
+		sep := collectPartsFromExpr(call.Args[1], pass)
+		var parts []log.LogPart
+		for i, elt := range lit.Elts {
+			if i > 0 {
+				parts = append(parts, sep...)
+			}
+			parts = append(parts, collectPartsFromExpr(elt, pass)...)
+		}
+		return parts, true
 	}
-	sel := callExpr.Fun.(*ast.SelectorExpr)
+	return nil, false
+}
 
+// collectArgsParts concatenates the parts of each argument.
+func collectArgsParts(args []ast.Expr, pass *analysis.Pass) []log.LogPart {
 	var parts []log.LogPart
-	if isFormatMethod(sel.Sel.Name) {
-		parts = append(parts, collectPartsFromExpr(callExpr.Args[0], info)...)
-		for _, arg := range callExpr.Args[1:] {
-			parts = append(parts, collectPartsFromExpr(arg, info)...)
-		}
-	} else {
-		parts = collectPartsFromExpr(callExpr.Args[0], info)
+	for _, arg := range args {
+		parts = append(parts, collectPartsFromExpr(arg, pass)...)
 	}
 	return parts
 }
 
-// handleLog processes a call to the standard library "log" package.
-func handleLog(pass *analysis.Pass, callExpr *ast.CallExpr, cfg *config.Config) {
-	parts := collectArgs(callExpr, pass.TypesInfo)
-	if len(parts) == 0 {
-		return
+// isFunc reports whether call statically calls the package-level function
+// pkgPath.name.
+func isFunc(info *types.Info, call *ast.CallExpr, pkgPath, name string) bool {
+	fn := typeutil.StaticCallee(info, call)
+	if fn == nil || fn.Pkg() == nil {
+		return false
 	}
-	analyzeMessage(pass, callExpr, parts, cfg)
+	return fn.Pkg().Path() == pkgPath && fn.Name() == name && fn.Type().(*types.Signature).Recv() == nil
 }
 
-// handleSlog processes a call to the "log/slog" package.
-// Only the first argument (the message string) is inspected.
-func handleSlog(pass *analysis.Pass, callExpr *ast.CallExpr, cfg *config.Config) {
-	if len(callExpr.Args) == 0 {
+// namedPart returns a non-literal LogPart named after the source of expr,
+// with the origin of its value when that is sensitive or untrusted input.
+func namedPart(expr ast.Expr, pass *analysis.Pass) log.LogPart {
+	part := log.LogPart{
+		Value:     types.ExprString(expr),
+		IsLiteral: false,
+		Expr:      expr,
+		Pos:       expr.Pos(),
+		End:       expr.End(),
+	}
+	if origins := lookupOrigins(pass); origins != nil {
+		part.Origin = origins.of(expr)
+	}
+	if inputs := lookupInputs(pass); inputs != nil {
+		part.Input = inputs.of(expr)
+	}
+	return part
+}
+
+// namedParts keeps only the non-literal parts: literal operands of a
+// comparison or arithmetic expression are not message text.
+func namedParts(parts []log.LogPart) []log.LogPart {
+	var named []log.LogPart
+	for _, part := range parts {
+		if !part.IsLiteral {
+			named = append(named, part)
+		}
+	}
+	return named
+}
+
+// resolvedPart returns a literal LogPart for an identifier (or pkg.Name
+// selector) whose string value is known: a constant declared in the analysed
+// module, or a local variable assigned exactly once from a literal. The part is
+// positioned at the call site and records the declaring literal in Decl when it
+// is in this package.
+func resolvedPart(expr ast.Expr, pass *analysis.Pass) (log.LogPart, bool) {
+	var ident *ast.Ident
+	switch e := expr.(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return log.LogPart{}, false
+	}
+	part := log.LogPart{
+		IsLiteral: true,
+		Name:      ident.Name,
+		Pos:       expr.Pos(),
+		End:       expr.End(),
+	}
+
+	obj := pass.TypesInfo.Uses[ident]
+	if !moduleConst(pass, obj) {
+		return log.LogPart{}, false
+	}
+	declared := pass.ResultOf[literalsAnalyzer].(declaredLiterals)
+	lit := declared.literals[obj]
+	if lit != nil {
+		part.Decl = lit.Pos()
+		part.SoleUse = declared.uses[obj] == 1 && !(obj.Exported() && obj.Parent() == pass.Pkg.Scope()) &&
+			pass.Fset.File(lit.Pos()) == pass.Fset.File(expr.Pos())
+	}
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		part.Value = constant.StringVal(tv.Value)
+		return part, true
+	}
+	if lit == nil {
+		return log.LogPart{}, false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return log.LogPart{}, false
+	}
+	part.Value = value
+	return part, true
+}
+
+// moduleConst reports whether obj, when it is a constant, is declared in the
+// analysed module: the text of constants such as time.RFC1123 or
+// http.MethodGet is not written by the module's authors. Where the module is
+// unknown, as under go vet and in GOPATH mode, only the constants of the
+// package itself qualify.
+func moduleConst(pass *analysis.Pass, obj types.Object) bool {
+	c, ok := obj.(*types.Const)
+	if !ok || c.Pkg() == nil || c.Pkg() == pass.Pkg {
+		return true
+	}
+	if pass.Module == nil || pass.Module.Path == "" {
+		return false
+	}
+	return inModule(pass, c.Pkg().Path())
+}
+
+// collectArgs extracts the message LogParts of an emitting call according to
+// fn. For format methods the format string and all of its operands are
+// collected; for print-style methods every argument from the message onward;
+// otherwise only the message argument is used.
+func collectArgs(callExpr *ast.CallExpr, fn logFunc, pass *analysis.Pass) []log.LogPart {
+	if fn.Msg == noArg || fn.Msg >= len(callExpr.Args) {
+		return nil
+	}
+
+	if fn.Format {
+		return formatParts(callExpr.Args[fn.Msg], callExpr.Args[fn.Msg+1:], callExpr.Ellipsis.IsValid(), pass)
+	}
+	if !fn.Print {
+		return collectPartsFromExpr(callExpr.Args[fn.Msg], pass)
+	}
+	return collectArgsParts(callExpr.Args[fn.Msg:], pass)
+}
+
+// leadingAttrArgs returns the arguments of an attribute constructor that come
+// before its key/value section, i.e. all of them when fn.Args is noArg.
+func leadingAttrArgs(callExpr *ast.CallExpr, fn logFunc) []ast.Expr {
+	if fn.Args == noArg || fn.Args > len(callExpr.Args) {
+		return callExpr.Args
+	}
+	return callExpr.Args[:fn.Args]
+}
+
+// trailingArgs returns the key/value, field or attribute arguments of a call
+// described by fn, or nil when it has none.
+func trailingArgs(callExpr *ast.CallExpr, fn logFunc) []ast.Expr {
+	if fn.Args == noArg || fn.Args >= len(callExpr.Args) {
+		return nil
+	}
+	return callExpr.Args[fn.Args:]
+}
+
+// handleCall analyses a recognised logging call described by fn: the message
+// of an emitting call goes through the whole filter pipeline, the leading
+// arguments of an attribute constructor only through the sensitive-data checks.
+// Key/value and field arguments are left to the library-specific handlers.
+func handleCall(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, cfg *config.Config) {
+	if fn.Kind == attrCall {
+		for _, arg := range leadingAttrArgs(callExpr, fn) {
+			if parts := collectPartsFromExpr(arg, pass); len(parts) > 0 {
+				analyzeAttributes(pass, callExpr, parts, cfg)
+			}
+		}
 		return
 	}
-	parts := collectPartsFromExpr(callExpr.Args[0], pass.TypesInfo)
+
+	if candidates := messageCandidates(pass, callExpr, fn); candidates != nil {
+		analyzeCandidates(pass, callExpr, fn.Level, candidates, cfg)
+		return
+	}
+	parts := collectArgs(callExpr, fn, pass)
 	if len(parts) == 0 {
 		return
 	}
-	analyzeMessage(pass, callExpr, parts, cfg)
+	analyzeMessage(pass, callExpr, fn.Level, parts, cfg)
 }
 
-// handleZap processes a call to "go.uber.org/zap".
-func handleZap(pass *analysis.Pass, callExpr *ast.CallExpr, cfg *config.Config) {
-	parts := collectArgs(callExpr, pass.TypesInfo)
-	if len(parts) == 0 {
+// analyzeKeyValues checks the key/value pairs that follow the message of a
+// call described by fn (see keyValueGroups) for sensitive data. It returns the
+// last key when it has no value, and nil otherwise.
+func analyzeKeyValues(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, isAttr func(types.Type) bool, cfg *config.Config) ast.Expr {
+	args := trailingArgs(callExpr, fn)
+	groups, dangling := keyValueGroups(pass.TypesInfo, args, callExpr.Ellipsis.IsValid(), isAttr)
+	analyzeGroups(pass, callExpr, groups, cfg)
+	if !dangling {
+		return nil
+	}
+	return args[len(args)-1]
+}
+
+// reportDanglingKey reports a key/value argument list whose last key has no
+// value; the logger would record it under a placeholder key.
+func reportDanglingKey(pass *analysis.Pass, key ast.Expr) {
+	pass.Reportf(key.Pos(), "log call has an odd number of key/value arguments: key has no value")
+}
+
+// analyzeGroups runs the sensitive-data checks over each group of arguments
+// (typically one key/value pair) as a separate LogContext, so diagnostics
+// point at the offending pair.
+func analyzeGroups(pass *analysis.Pass, callExpr *ast.CallExpr, groups [][]ast.Expr, cfg *config.Config) {
+	for _, group := range groups {
+		var parts []log.LogPart
+		for _, expr := range group {
+			parts = append(parts, collectPartsFromExpr(expr, pass)...)
+		}
+		if len(parts) > 0 {
+			analyzeAttributes(pass, callExpr, parts, cfg)
+		}
+	}
+}
+
+// handleLog processes a call to the standard library "log" package.
+func handleLog(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, cfg *config.Config) {
+	handleCall(pass, callExpr, fn, cfg)
+}
+
+// handleSlog processes a call to the "log/slog" package. Besides the message,
+// the key/value pairs and slog.Attr arguments that follow it are checked for
+// sensitive data.
+func handleSlog(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, cfg *config.Config) {
+	handleCall(pass, callExpr, fn, cfg)
+	analyzeKeyValues(pass, callExpr, fn, isSlogAttr, cfg)
+}
+
+// handleZap processes a call to "go.uber.org/zap". The zap.Field arguments
+// of Logger methods and the key/value pairs of the SugaredLogger *w methods
+// are checked for sensitive data, and a key/value list with a key missing its
+// value is reported.
+func handleZap(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, cfg *config.Config) {
+	handleCall(pass, callExpr, fn, cfg)
+	if key := analyzeKeyValues(pass, callExpr, fn, isZapField, cfg); key != nil {
+		reportDanglingKey(pass, key)
+	}
+}
+
+// handleLogrus processes a call to "github.com/sirupsen/logrus". The key and
+// value of WithField and the entries of a logrus.Fields literal passed to
+// WithFields are checked for sensitive data.
+func handleLogrus(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, cfg *config.Config) {
+	handleCall(pass, callExpr, fn, cfg)
+
+	var groups [][]ast.Expr
+	for _, arg := range trailingArgs(callExpr, fn) {
+		lit, ok := ast.Unparen(arg).(*ast.CompositeLit)
+		if !ok || !isNamedType(pass.TypesInfo.TypeOf(lit), "github.com/sirupsen/logrus", "Fields") {
+			groups = append(groups, []ast.Expr{arg})
+			continue
+		}
+		for _, elt := range lit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				groups = append(groups, []ast.Expr{kv.Key, kv.Value})
+			}
+		}
+	}
+	analyzeGroups(pass, callExpr, groups, cfg)
+}
+
+// handleZerolog processes a call to "github.com/rs/zerolog". A zerolog log
+// statement is a chain such as log.Info().Str("k", v).Msgf("...", args): the
+// chain is walked back from the terminal Msg/Msgf/Send to the level method, so
+// the message gets the full pipeline at the right level and every field of the
+// chain gets the sensitive-data checks. Context field calls (Logger.With())
+// are analysed on their own.
+func handleZerolog(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, cfg *config.Config) {
+	sel, ok := ast.Unparen(callExpr.Fun).(*ast.SelectorExpr)
+	if fn.Kind == attrCall {
+		if ok {
+			analyzeZerologField(pass, callExpr, sel.Sel.Name, cfg)
+		}
 		return
 	}
-	analyzeMessage(pass, callExpr, parts, cfg)
-}
\ No newline at end of file
+
+	if ok && fn.Level == "" {
+		var fields []*ast.CallExpr
+		fn.Level, fields = zerologChain(pass.TypesInfo, sel.X)
+		for _, field := range fields {
+			analyzeZerologField(pass, field, field.Fun.(*ast.SelectorExpr).Sel.Name, cfg)
+		}
+	}
+	handleCall(pass, callExpr, fn, cfg)
+}
+
+// analyzeZerologField checks the key and value of a single zerolog field call
+// such as Str("k", v). The fields of a nested Dict chain are checked as well.
+func analyzeZerologField(pass *analysis.Pass, callExpr *ast.CallExpr, name string, cfg *config.Config) {
+	fn := zerologFields[name]
+	handleCall(pass, callExpr, fn, cfg)
+
+	args := trailingArgs(callExpr, fn)
+	if name == "Dict" {
+		for _, arg := range args {
+			_, fields := zerologChain(pass.TypesInfo, arg)
+			for _, field := range fields {
+				analyzeZerologField(pass, field, field.Fun.(*ast.SelectorExpr).Sel.Name, cfg)
+			}
+		}
+		return
+	}
+	groups, _ := keyValueGroups(pass.TypesInfo, args, false, nil)
+	analyzeGroups(pass, callExpr, groups, cfg)
+}
+
+// zerologChain walks a zerolog event chain backwards from expr, collecting the
+// *Event field calls, and returns the level of the method that started the
+// chain (empty when it cannot be told, e.g. Log() or a dictionary).
+func zerologChain(info *types.Info, expr ast.Expr) (level string, fields []*ast.CallExpr) {
+	for {
+		call, ok := ast.Unparen(expr).(*ast.CallExpr)
+		if !ok {
+			return "", fields
+		}
+		sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+		callee := typeutil.StaticCallee(info, call)
+		if callee == nil || callee.Pkg() == nil || !strings.HasPrefix(callee.Pkg().Path(), "github.com/rs/zerolog") {
+			return "", fields
+		}
+
+		name := callee.Name()
+		if _, isField := zerologFields[name]; isField && receiverName(callee) == "Event" {
+			if !ok {
+				return "", fields
+			}
+			fields = append(fields, call)
+			expr = sel.X
+			continue
+		}
+
+		switch name {
+		case "Trace", "Debug", "Info", "Warn", "Error", "Fatal", "Panic":
+			return levelName(name), fields
+		case "Err":
+			return "error", fields
+		case "WithLevel":
+			return zerologLevelArg(call), fields
+		}
+		return "", fields
+	}
+}
+
+// zerologLevelArg returns the level named by the argument of WithLevel when it
+// is one of the zerolog level constants, e.g. zerolog.WarnLevel.
+func zerologLevelArg(call *ast.CallExpr) string {
+	if len(call.Args) != 1 {
+		return ""
+	}
+	var name string
+	switch arg := ast.Unparen(call.Args[0]).(type) {
+	case *ast.SelectorExpr:
+		name = arg.Sel.Name
+	case *ast.Ident:
+		name = arg.Name
+	}
+	if !strings.HasSuffix(name, "Level") {
+		return ""
+	}
+	return levelName(strings.TrimSuffix(name, "Level"))
+}
+
+// handleKeyValueCall processes a call to a logger whose structured fields are
+// untyped key/value pairs following the message:
+//   - "github.com/go-logr/logr": the message is the first argument of Info and
+//     the second of Error(err, msg, kv...); calls made through a V(n)
+//     verbosity chain resolve to the same Logger methods;
+//   - "k8s.io/klog/v2", including the methods of the Verbose value returned by
+//     klog.V(n): the pairs of the structured InfoS/ErrorS calls;
+//   - "github.com/hashicorp/go-hclog": the pairs after the message and those
+//     passed to With.
+//
+// The key/value pairs are checked for sensitive data.
+func handleKeyValueCall(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, cfg *config.Config) {
+	handleCall(pass, callExpr, fn, cfg)
+	if key := analyzeKeyValues(pass, callExpr, fn, nil, cfg); key != nil {
+		reportDanglingKey(pass, key)
+	}
+}
+
+// handleGoKit processes a call to go-kit's log package. Logger.Log has no
+// message argument: the value following the "msg" key is run through the full
+// pipeline and every other pair through the sensitive-data checks. The level
+// is taken from a level.Info(logger)-style receiver.
+func handleGoKit(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, cfg *config.Config) {
+	handleCall(pass, callExpr, fn, cfg)
+
+	args := trailingArgs(callExpr, fn)
+	groups, dangling := keyValueGroups(pass.TypesInfo, args, callExpr.Ellipsis.IsValid(), nil)
+	var attrs [][]ast.Expr
+	for _, group := range groups {
+		if fn.Kind != emitCall || len(group) != 2 || !isConstString(pass.TypesInfo, group[0], "msg") {
+			attrs = append(attrs, group)
+			continue
+		}
+		if parts := collectPartsFromExpr(group[1], pass); len(parts) > 0 {
+			analyzeMessage(pass, callExpr, gokitLevel(pass.TypesInfo, callExpr), parts, cfg)
+		}
+	}
+	analyzeGroups(pass, callExpr, attrs, cfg)
+	if dangling {
+		reportDanglingKey(pass, args[len(args)-1])
+	}
+}
+
+// gokitLevel returns the level of a Log call made on a logger returned by
+// go-kit's level.Debug/Info/Warn/Error, or "" otherwise.
+func gokitLevel(info *types.Info, callExpr *ast.CallExpr) string {
+	sel, ok := ast.Unparen(callExpr.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	recv, ok := ast.Unparen(sel.X).(*ast.CallExpr)
+	if !ok {
+		return ""
+	}
+	callee := typeutil.StaticCallee(info, recv)
+	if callee == nil || callee.Pkg() == nil {
+		return ""
+	}
+	switch callee.Pkg().Path() {
+	case "github.com/go-kit/log/level", "github.com/go-kit/kit/log/level":
+		switch name := callee.Name(); name {
+		case "Debug", "Info", "Warn", "Error":
+			return levelName(name)
+		}
+	}
+	return ""
+}
+
+// handleCustom processes a call to a logger declared in the "loggers" section
+// of the configuration or to a method of a logger-shaped interface. Arguments
+// from the args index onward are checked for sensitive data as key/value
+// pairs or individual fields.
+func handleCustom(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc, cfg *config.Config) {
+	handleCall(pass, callExpr, fn, cfg)
+	analyzeKeyValues(pass, callExpr, fn, nil, cfg)
+}
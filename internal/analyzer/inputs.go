@@ -0,0 +1,172 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// inputFact is exported for a function of the package one of whose results
+// holds untrusted input, so calls to it are input sources in importing
+// packages too.
+type inputFact struct {
+	// Result is the index of the result holding input.
+	Result int
+	// Origin describes the input.
+	Origin string
+}
+
+func (*inputFact) AFact() {}
+
+func (f *inputFact) String() string { return fmt.Sprintf("input(%d, %s)", f.Result, f.Origin) }
+
+// inputsByPass holds the origins of untrusted input of the passes with the
+// log injection filter enabled.
+var inputsByPass sync.Map
+
+// lookupInputs returns the origins of untrusted input of pass, or nil when
+// its log injection filter is disabled.
+func lookupInputs(pass *analysis.Pass) *origins {
+	if o, ok := inputsByPass.Load(pass); ok {
+		return o.(*origins)
+	}
+	return nil
+}
+
+// requestFields are the fields of an http.Request, and of its URL, that a
+// client controls.
+var requestFields = map[string]string{
+	"Host":       "the request host",
+	"RequestURI": "the request URI",
+	"Path":       "the request URL path",
+	"RawPath":    "the request URL path",
+	"RawQuery":   "the request query",
+	"Fragment":   "the request URL fragment",
+}
+
+// inputSources are the sources of input an attacker controls: the fields,
+// parameters, headers, cookies and body of an HTTP request, and lines read
+// with bufio. Quoting and escaping functions make them safe.
+type inputSources struct{}
+
+func (inputSources) expr(o *origins, expr ast.Expr) string {
+	info := o.pass.TypesInfo
+	switch e := expr.(type) {
+	case *ast.SelectorExpr:
+		x := info.TypeOf(e.X)
+		switch {
+		case isNamedType(x, "net/http", "Request") && (e.Sel.Name == "Host" || e.Sel.Name == "RequestURI"):
+			return requestFields[e.Sel.Name]
+		case isNamedType(x, "net/url", "URL") && requestURL(info, e.X) && requestFields[e.Sel.Name] != "":
+			return requestFields[e.Sel.Name]
+		case isNamedType(x, "net/http", "Cookie") && e.Sel.Name == "Value":
+			return "a cookie value"
+		case isNamedType(x, "net/http", "Request") && (e.Sel.Name == "Form" || e.Sel.Name == "PostForm" || e.Sel.Name == "Header"):
+			return "a request " + strings.ToLower(strings.TrimPrefix(e.Sel.Name, "Post"))
+		}
+	case *ast.IndexExpr:
+		switch t := info.TypeOf(e.X); {
+		case isNamedType(t, "net/http", "Header"):
+			return headerInput(info, e.Index)
+		case isNamedType(t, "net/url", "Values"):
+			return parameterInput(info, e.Index)
+		}
+	}
+	return ""
+}
+
+func (inputSources) call(o *origins, call *ast.CallExpr, fn *types.Func, i int) (string, bool) {
+	info := o.pass.TypesInfo
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		switch {
+		case isFunc(info, call, "strconv", "Quote"), isFunc(info, call, "strconv", "QuoteToASCII"),
+			isFunc(info, call, "net/url", "QueryEscape"), isFunc(info, call, "net/url", "PathEscape"),
+			isFunc(info, call, "html", "EscapeString"):
+			return "", true
+		case isFunc(info, call, "strings", "ReplaceAll") || isFunc(info, call, "strings", "Replace"):
+			// strings.ReplaceAll(s, "\n", " ") removes line breaks.
+			if old, ok := constString(info, call.Args[1]); ok && strings.ContainsAny(old, "\r\n") {
+				return "", true
+			}
+		case isFunc(info, call, "io", "ReadAll") || isFunc(info, call, "io/ioutil", "ReadAll"):
+			if sel, ok := ast.Unparen(call.Args[0]).(*ast.SelectorExpr); ok && sel.Sel.Name == "Body" && i == 0 {
+				if isNamedType(info.TypeOf(sel.X), "net/http", "Request") {
+					return "the request body", true
+				}
+			}
+		}
+		return "", false
+	}
+	switch t := recv.Type(); {
+	case isNamedType(t, "net/http", "Request"):
+		switch fn.Name() {
+		case "FormValue", "PostFormValue":
+			return parameterInput(info, call.Args[0]), true
+		case "UserAgent":
+			return "the User-Agent header", true
+		case "Referer":
+			return "the Referer header", true
+		case "BasicAuth":
+			if i == 0 {
+				return "the HTTP basic auth username", true
+			}
+		}
+	case isNamedType(t, "net/http", "Header") && (fn.Name() == "Get" || fn.Name() == "Values"):
+		return headerInput(info, call.Args[0]), true
+	case isNamedType(t, "net/url", "Values") && fn.Name() == "Get":
+		return parameterInput(info, call.Args[0]), true
+	case isNamedType(t, "net/url", "URL") && (fn.Name() == "Query" || fn.Name() == "EscapedPath") && i == 0:
+		if sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr); ok && requestURL(info, sel.X) {
+			if fn.Name() == "Query" {
+				return "a request parameter", true
+			}
+			return "the request URL path", true
+		}
+	case isNamedType(t, "bufio", "Scanner") && (fn.Name() == "Text" || fn.Name() == "Bytes"):
+		return "input read with bufio.Scanner", true
+	case isNamedType(t, "bufio", "Reader") && (fn.Name() == "ReadString" || fn.Name() == "ReadBytes" || fn.Name() == "ReadLine") && i == 0:
+		return "input read with bufio.Reader", true
+	}
+	return "", false
+}
+
+func (inputSources) node(*origins, ast.Node) {}
+
+func (inputSources) exportFact(pass *analysis.Pass, fn *types.Func, fact taintFact) {
+	pass.ExportObjectFact(fn, &inputFact{Result: fact.Result, Origin: fact.Origin})
+}
+
+func (inputSources) importFact(pass *analysis.Pass, fn *types.Func) (taintFact, bool) {
+	var fact inputFact
+	if !pass.ImportObjectFact(fn, &fact) {
+		return taintFact{}, false
+	}
+	return taintFact{Result: fact.Result, Origin: fact.Origin}, true
+}
+
+// requestURL reports whether expr is the URL field of an http.Request.
+func requestURL(info *types.Info, expr ast.Expr) bool {
+	sel, ok := ast.Unparen(expr).(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "URL" && isNamedType(info.TypeOf(sel.X), "net/http", "Request")
+}
+
+// parameterInput describes the request parameter named by key.
+func parameterInput(info *types.Info, key ast.Expr) string {
+	if name, ok := constString(info, key); ok {
+		return fmt.Sprintf("the request parameter %q", name)
+	}
+	return "a request parameter"
+}
+
+// headerInput describes the request header named by key.
+func headerInput(info *types.Info, key ast.Expr) string {
+	if name, ok := constString(info, key); ok {
+		return "the " + name + " header"
+	}
+	return "a request header"
+}
@@ -0,0 +1,102 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+)
+
+// keyValueGroups splits variadic logging arguments in the slog convention
+// (alternating string keys and values, interleaved with self-contained
+// attributes) into groups that are checked together: a key with its value,
+// or a lone attribute or value.
+//
+// isAttr reports whether an argument type is a self-contained attribute such
+// as slog.Attr. Attributes built by a recognised constructor call are skipped,
+// because the constructor call is analysed on its own when the inspector
+// reaches it. When spread is true the last argument is a slice passed with
+// "...", which is checked as a single value.
+//
+// dangling is true when the last key has no value.
+func keyValueGroups(info *types.Info, args []ast.Expr, spread bool, isAttr func(types.Type) bool) (groups [][]ast.Expr, dangling bool) {
+	var rest ast.Expr
+	if spread && len(args) > 0 {
+		rest = args[len(args)-1]
+		args = args[:len(args)-1]
+	}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		t := info.TypeOf(arg)
+		switch {
+		case t != nil && isAttr != nil && isAttr(t):
+			if !isAttrConstructorCall(info, arg) {
+				groups = append(groups, []ast.Expr{arg})
+			}
+		case isStringType(t):
+			if i+1 == len(args) {
+				groups = append(groups, []ast.Expr{arg})
+				dangling = true
+				continue
+			}
+			groups = append(groups, []ast.Expr{arg, args[i+1]})
+			i++
+		default:
+			groups = append(groups, []ast.Expr{arg})
+		}
+	}
+	if rest != nil {
+		groups = append(groups, []ast.Expr{rest})
+	}
+	return groups, dangling
+}
+
+// isAttrConstructorCall reports whether expr is a call to an attribute
+// constructor listed in logAPIs.
+func isAttrConstructorCall(info *types.Info, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	_, fn, ok := resolveLogFunc(info, call)
+	return ok && fn.Kind == attrCall
+}
+
+// isConstString reports whether expr is a string constant equal to value.
+func isConstString(info *types.Info, expr ast.Expr, value string) bool {
+	tv, ok := info.Types[expr]
+	return ok && tv.Value != nil && tv.Value.Kind() == constant.String && constant.StringVal(tv.Value) == value
+}
+
+// isStringType reports whether t is a (possibly untyped) string type.
+func isStringType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
+
+// isNamedType reports whether t, with any pointer and alias stripped, is the
+// named type pkgPath.name.
+func isNamedType(t types.Type, pkgPath, name string) bool {
+	t = types.Unalias(t)
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = types.Unalias(ptr.Elem())
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == pkgPath && named.Obj().Name() == name
+}
+
+// isSlogAttr reports whether t is slog.Attr.
+func isSlogAttr(t types.Type) bool {
+	return isNamedType(t, "log/slog", "Attr")
+}
+
+// isZapField reports whether t is zap.Field (an alias of zapcore.Field).
+func isZapField(t types.Type) bool {
+	return isNamedType(t, "go.uber.org/zap/zapcore", "Field") || isNamedType(t, "go.uber.org/zap", "Field")
+}
@@ -0,0 +1,108 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// declaredLiterals maps constants and single-assignment local variables of a
+// package to the string literal they are declared with, and counts their uses.
+type declaredLiterals struct {
+	literals map[types.Object]*ast.BasicLit
+	uses     map[types.Object]int
+}
+
+// literalsAnalyzer records, for the package under analysis, the string literal
+// behind each constant and each local variable that is assigned exactly once
+// from a literal, so log messages built from them can be checked as text.
+var literalsAnalyzer = &analysis.Analyzer{
+	Name:       "lingoliterals",
+	Doc:        "records the string literals that constants and single-assignment locals are declared with",
+	Run:        runLiterals,
+	Requires:   []*analysis.Analyzer{inspect.Analyzer},
+	ResultType: reflect.TypeOf(declaredLiterals{}),
+}
+
+func runLiterals(pass *analysis.Pass) (interface{}, error) {
+	inspector := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodeFilter := []ast.Node{
+		(*ast.ValueSpec)(nil),
+		(*ast.AssignStmt)(nil),
+		(*ast.UnaryExpr)(nil),
+		(*ast.RangeStmt)(nil),
+	}
+
+	literals := map[types.Object]*ast.BasicLit{}
+	reassigned := map[types.Object]bool{}
+	disqualify := func(expr ast.Expr) {
+		if ident, ok := ast.Unparen(expr).(*ast.Ident); ok {
+			if obj := pass.TypesInfo.Uses[ident]; obj != nil {
+				reassigned[obj] = true
+			}
+		}
+	}
+	record := func(ident *ast.Ident, value ast.Expr) {
+		obj := pass.TypesInfo.Defs[ident]
+		if obj == nil {
+			return
+		}
+		if _, isVar := obj.(*types.Var); isVar && obj.Parent() == pass.Pkg.Scope() {
+			// Package-level variables may be assigned from anywhere.
+			return
+		}
+		if lit, ok := ast.Unparen(value).(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			literals[obj] = lit
+		}
+	}
+
+	inspector.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			if len(n.Values) != len(n.Names) {
+				return
+			}
+			for i, name := range n.Names {
+				record(name, n.Values[i])
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range n.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if n.Tok == token.DEFINE && ok && pass.TypesInfo.Defs[ident] != nil {
+					if len(n.Rhs) == len(n.Lhs) {
+						record(ident, n.Rhs[i])
+					}
+					continue
+				}
+				disqualify(lhs)
+			}
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				disqualify(n.X)
+			}
+		case *ast.RangeStmt:
+			if n.Tok == token.ASSIGN {
+				disqualify(n.Key)
+				if n.Value != nil {
+					disqualify(n.Value)
+				}
+			}
+		}
+	})
+
+	for obj := range reassigned {
+		delete(literals, obj)
+	}
+	uses := map[types.Object]int{}
+	for _, obj := range pass.TypesInfo.Uses {
+		if literals[obj] != nil {
+			uses[obj]++
+		}
+	}
+	return declaredLiterals{literals: literals, uses: uses}, nil
+}
@@ -13,14 +13,53 @@ type LogPart struct {
 	// Value holds the literal text or the identifier name.
 	Value string
 	// IsLiteral is true for string literals and false for variables/expressions.
+	// Constants and single-assignment locals whose text is known are literals too.
 	IsLiteral bool
+	// Name is the identifier a literal part was resolved from, empty for
+	// literals written directly in the call.
+	Name string
+	// Decl is the position of the string literal a resolved part was declared
+	// with, or token.NoPos when the declaration is outside the package.
+	Decl token.Pos
+	// SoleUse is true when the call is the only use of the declaration of a
+	// resolved part and is in the same file, so editing the declaring literal
+	// changes this message alone.
+	SoleUse bool
+	// Expr is the expression a non-literal part comes from, if any.
+	Expr ast.Expr
+	// Origin describes the sensitive value a variable holds when its type
+	// alone does not tell, e.g. "a database/sql DSN".
+	Origin string
+	// Input describes the untrusted input a non-literal part holds, e.g.
+	// "the request parameter \"q\"", or is empty.
+	Input string
+	// Verbs are the printf verbs of a format string part, paired with the
+	// arguments they format.
+	Verbs     []FormatVerb
 	Pos       token.Pos
 	End       token.Pos
 }
+
+// FormatVerb is a printf verb of a format string and the argument it formats.
+type FormatVerb struct {
+	// Verb is the verb as written, e.g. "%s" or "%+v".
+	Verb string
+	// Offset is the byte offset of the verb in the format string.
+	Offset int
+	// Arg is the source of the formatted argument, empty when it is missing.
+	Arg string
+	// Pos and End delimit the formatted argument, if any.
+	Pos token.Pos
+	End token.Pos
+}
+
 // LogContext carries the data a LogFilter needs to inspect a single log call.
 type LogContext struct {
 	Pass     *analysis.Pass
 	CallExpr *ast.CallExpr
+	// Level is the severity of the log call (e.g. "info", "error") when the
+	// handler can tell it, and empty otherwise.
+	Level    string
 	Parts    []LogPart
 	// FullText is the concatenation of all Part values for convenience.
 	FullText string
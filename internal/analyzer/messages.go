@@ -0,0 +1,318 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+)
+
+// maxMessageValues bounds the number of candidate messages computed for a
+// single expression, so that chains of conditional concatenations stay cheap.
+const maxMessageValues = 16
+
+// messageFact is exported, in SSA mode, for a function whose string result is
+// always one of a known set of constants. Log calls in importing packages that
+// use its result as a message are checked against each value.
+type messageFact struct {
+	Values []string
+}
+
+func (*messageFact) AFact() {}
+
+func (f *messageFact) String() string {
+	quoted := make([]string, len(f.Values))
+	for i, v := range f.Values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return "messages(" + strings.Join(quoted, ", ") + ")"
+}
+
+// messageValues computes the possible constant values of message expressions
+// from the SSA form of the package under analysis.
+type messageValues struct {
+	pass *analysis.Pass
+	prog *ssa.Program
+	// exprs maps each expression of the package to its SSA value.
+	exprs map[ast.Expr]ssa.Value
+	// results memoises the possible return values of package functions; a nil
+	// entry marks a function that is being evaluated or has no constant result.
+	results map[*ssa.Function][]string
+	// visiting guards against cycles through φ-nodes.
+	visiting map[ssa.Value]bool
+}
+
+// messageValuesByPass holds the messageValues of the passes running in SSA
+// mode, so handlers can look them up without threading them through.
+var messageValuesByPass sync.Map
+
+// newMessageValues builds the SSA form of the package under analysis, with
+// debug information that links expressions to their values. The packages it
+// imports, directly or not, are created from their type information only. It
+// returns an error when the SSA builder cannot handle the package, which is
+// then analysed syntactically only.
+func newMessageValues(pass *analysis.Pass) (m *messageValues, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			m, err = nil, fmt.Errorf("%v", r)
+		}
+	}()
+
+	prog := ssa.NewProgram(pass.Fset, ssa.GlobalDebug)
+	created := map[*types.Package]bool{pass.Pkg: true}
+	var createAll func(pkgs []*types.Package)
+	createAll = func(pkgs []*types.Package) {
+		for _, p := range pkgs {
+			if !created[p] {
+				created[p] = true
+				prog.CreatePackage(p, nil, nil, true)
+				createAll(p.Imports())
+			}
+		}
+	}
+	createAll(pass.Pkg.Imports())
+	pkg := prog.CreatePackage(pass.Pkg, pass.Files, pass.TypesInfo, false)
+	pkg.Build()
+
+	m = &messageValues{
+		pass:     pass,
+		prog:     prog,
+		exprs:    map[ast.Expr]ssa.Value{},
+		results:  map[*ssa.Function][]string{},
+		visiting: map[ssa.Value]bool{},
+	}
+	var addRefs func(fn *ssa.Function)
+	addRefs = func(fn *ssa.Function) {
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				if ref, ok := instr.(*ssa.DebugRef); ok && !ref.IsAddr {
+					m.exprs[ref.Expr] = ref.X
+				}
+			}
+		}
+		for _, anon := range fn.AnonFuncs {
+			addRefs(anon)
+		}
+	}
+	for _, fn := range packageFuncs(pass) {
+		if f := prog.FuncValue(fn); f != nil {
+			addRefs(f)
+		}
+	}
+	return m, nil
+}
+
+// ssaFallbacks holds the paths of the packages whose SSA form could not be
+// built, so each is warned about once.
+var ssaFallbacks sync.Map
+
+// warnSSAFallback tells on stderr that the package at pkgPath is checked
+// without SSA mode. It is not a diagnostic: the condition says nothing about
+// the code under analysis and must not fail the run.
+func warnSSAFallback(pkgPath string, err error) {
+	if _, warned := ssaFallbacks.LoadOrStore(pkgPath, true); !warned {
+		fmt.Fprintf(os.Stderr, "lingo: ssa mode is unavailable for package %s, its messages are checked syntactically: %v\n", pkgPath, err)
+	}
+}
+
+// lookupMessageValues returns the messageValues of pass, or nil when the pass
+// does not run in SSA mode.
+func lookupMessageValues(pass *analysis.Pass) *messageValues {
+	if m, ok := messageValuesByPass.Load(pass); ok {
+		return m.(*messageValues)
+	}
+	return nil
+}
+
+// packageFuncs returns the functions and methods declared in the package.
+func packageFuncs(pass *analysis.Pass) []*types.Func {
+	var funcs []*types.Func
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok {
+				if fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func); ok {
+					funcs = append(funcs, fn)
+				}
+			}
+		}
+	}
+	return funcs
+}
+
+// exportFacts exports a messageFact for each function of the package that
+// returns one of a known set of string constants.
+func (m *messageValues) exportFacts() {
+	for _, fn := range packageFuncs(m.pass) {
+		f := m.prog.FuncValue(fn)
+		if f == nil {
+			continue
+		}
+		if values, ok := m.returns(f); ok && len(values) > 0 {
+			m.pass.ExportObjectFact(fn, &messageFact{Values: values})
+		}
+	}
+}
+
+// candidates returns the possible constant values of expr, or false when some
+// path produces a value that is not known at compile time.
+func (m *messageValues) candidates(expr ast.Expr) ([]string, bool) {
+	v, ok := m.exprs[ast.Unparen(expr)]
+	if !ok {
+		return nil, false
+	}
+	return m.values(v)
+}
+
+// values returns the possible string constants v may hold: constants,
+// φ-nodes merging branches, concatenations, conversions between string types
+// and calls to functions with known results.
+func (m *messageValues) values(v ssa.Value) ([]string, bool) {
+	if m.visiting[v] {
+		return nil, false
+	}
+	m.visiting[v] = true
+	defer delete(m.visiting, v)
+
+	switch v := v.(type) {
+	case *ssa.Const:
+		if v.Value == nil || v.Value.Kind() != constant.String {
+			return nil, false
+		}
+		return []string{constant.StringVal(v.Value)}, true
+	case *ssa.ChangeType:
+		return m.values(v.X)
+	case *ssa.Phi:
+		var all []string
+		for _, edge := range v.Edges {
+			values, ok := m.values(edge)
+			if !ok {
+				return nil, false
+			}
+			all = appendUnique(all, values...)
+		}
+		return all, len(all) <= maxMessageValues
+	case *ssa.BinOp:
+		if v.Op != token.ADD {
+			return nil, false
+		}
+		left, ok := m.values(v.X)
+		if !ok {
+			return nil, false
+		}
+		right, ok := m.values(v.Y)
+		if !ok || len(left)*len(right) > maxMessageValues {
+			return nil, false
+		}
+		var all []string
+		for _, l := range left {
+			for _, r := range right {
+				all = appendUnique(all, l+r)
+			}
+		}
+		return all, true
+	case *ssa.Call:
+		callee := v.Call.StaticCallee()
+		if callee == nil {
+			return nil, false
+		}
+		if callee.Blocks != nil {
+			return m.returns(callee)
+		}
+		if obj, ok := callee.Object().(*types.Func); ok {
+			var fact messageFact
+			if m.pass.ImportObjectFact(obj.Origin(), &fact) {
+				return fact.Values, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// returns returns the possible values of the single string result of fn.
+func (m *messageValues) returns(fn *ssa.Function) ([]string, bool) {
+	if values, ok := m.results[fn]; ok {
+		return values, values != nil
+	}
+	if fn.Signature.Results().Len() != 1 || !isStringType(fn.Signature.Results().At(0).Type()) {
+		return nil, false
+	}
+	m.results[fn] = nil
+
+	var all []string
+	for _, b := range fn.Blocks {
+		ret, ok := b.Instrs[len(b.Instrs)-1].(*ssa.Return)
+		if !ok {
+			continue
+		}
+		values, ok := m.values(ret.Results[0])
+		if !ok {
+			return nil, false
+		}
+		all = appendUnique(all, values...)
+	}
+	if len(all) == 0 || len(all) > maxMessageValues {
+		return nil, false
+	}
+	m.results[fn] = all
+	return all, true
+}
+
+// appendUnique appends the values not already present in list.
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// messageCandidates returns, in SSA mode, one list of parts per possible
+// value of the message argument of a call described by fn, followed by the
+// parts of its operands. It returns nil when the message is plain text or its
+// values cannot all be determined.
+func messageCandidates(pass *analysis.Pass, callExpr *ast.CallExpr, fn logFunc) [][]log.LogPart {
+	m := lookupMessageValues(pass)
+	if m == nil || fn.Msg == noArg || fn.Msg >= len(callExpr.Args) {
+		return nil
+	}
+	arg := callExpr.Args[fn.Msg]
+	resolved := true
+	for _, part := range collectPartsFromExpr(arg, pass) {
+		resolved = resolved && part.IsLiteral
+	}
+	if resolved {
+		return nil
+	}
+	values, ok := m.candidates(arg)
+	if !ok || len(values) == 0 {
+		return nil
+	}
+
+	var rest []log.LogPart
+	if fn.Format || fn.Print {
+		rest = collectArgsParts(callExpr.Args[fn.Msg+1:], pass)
+	}
+	candidates := make([][]log.LogPart, 0, len(values))
+	for _, value := range values {
+		part := log.LogPart{
+			Value:     value,
+			IsLiteral: true,
+			Name:      types.ExprString(arg),
+			Pos:       arg.Pos(),
+			End:       arg.End(),
+		}
+		candidates = append(candidates, append([]log.LogPart{part}, rest...))
+	}
+	return candidates
+}
@@ -0,0 +1,570 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/PriestFaria/lingo/internal/config"
+	"github.com/PriestFaria/lingo/internal/filters"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// maxTaintRounds bounds the passes over the package made to propagate taint
+// through assignments and function results declared out of order.
+const maxTaintRounds = 8
+
+// taintFact is exported for a function of the package one of whose results
+// holds a sensitive value, so calls to it are taint sources in importing
+// packages too.
+type taintFact struct {
+	// Result is the index of the tainted result.
+	Result int
+	// Origin describes the sensitive value.
+	Origin string
+}
+
+func (*taintFact) AFact() {}
+
+func (f *taintFact) String() string { return fmt.Sprintf("taints(%d, %s)", f.Result, f.Origin) }
+
+// sensitiveHeaders are the HTTP headers carrying credentials.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// taintPackages are the packages whose functions return a value derived from
+// their arguments, so that a tainted argument taints the result.
+var taintPackages = []string{"strings", "bytes", "strconv", "fmt", "encoding/base64", "encoding/hex", "net/url", "path", "path/filepath"}
+
+// taintSources defines what an origins analysis tracks: the expressions and
+// calls its values come from, and the fact recording the functions that
+// return them.
+type taintSources interface {
+	// expr describes the value read by a selector, index expression or
+	// composite literal that is a source, or returns "".
+	expr(o *origins, e ast.Expr) string
+	// call describes the value held by the i-th result of a call to fn. It
+	// reports false when fn is neither a source nor a function that makes
+	// its arguments safe, so that taint propagates through it as usual.
+	call(o *origins, call *ast.CallExpr, fn *types.Func, i int) (string, bool)
+	// node records the variables a statement or call other than an
+	// assignment makes hold a tracked value.
+	node(o *origins, n ast.Node)
+	// exportFact exports fact for fn, and importFact imports it.
+	exportFact(pass *analysis.Pass, fn *types.Func, fact taintFact)
+	importFact(pass *analysis.Pass, fn *types.Func) (taintFact, bool)
+}
+
+// origins tracks values of interest through the package under analysis, from
+// the sources its taintSources define, through assignments, string operations
+// and function results, up to a sanitizer. The analysis is flow-insensitive:
+// a variable assigned a tracked value anywhere holds it everywhere, except
+// after a later assignment in the same or an enclosing block, such as
+// v = mask.String(v), which decides what it holds from there on.
+type origins struct {
+	pass    *analysis.Pass
+	sources taintSources
+	// sanitizers recognises the calls whose result is safe.
+	sanitizers *filters.SecurityFilter
+	// vars maps variables to a description of the value they hold.
+	vars map[types.Object]string
+	// assigns records the assignments to local variables, by the position
+	// of their statement.
+	assigns map[types.Object]map[token.Pos]assignment
+	// funcs records the tainted result of the functions of the package.
+	funcs map[*types.Func]taintFact
+	// changed is set when a round records a new variable or function.
+	changed bool
+}
+
+// assignment is an assignment of a value to a local variable.
+type assignment struct {
+	// end is the end of the assigning statement.
+	end token.Pos
+	// block is the innermost block holding the statement, within which the
+	// value replaces the one assigned before.
+	block ast.Node
+	// value describes the tracked value assigned, or returns "".
+	value func() string
+}
+
+// originsByPass holds the origins of sensitive values of the passes with the
+// security filter enabled, so handlers can look them up without threading
+// them through.
+var originsByPass sync.Map
+
+// newOrigins propagates the values sources defines through the package under
+// analysis until no new variable or function is found to hold one.
+func newOrigins(pass *analysis.Pass, cfg *config.Config, sources taintSources) *origins {
+	o := &origins{
+		pass:       pass,
+		sources:    sources,
+		sanitizers: &filters.SecurityFilter{Sanitizers: cfg.Security.Sanitizers},
+		vars:       map[types.Object]string{},
+		assigns:    map[types.Object]map[token.Pos]assignment{},
+		funcs:      map[*types.Func]taintFact{},
+	}
+	for round := 0; round == 0 || (o.changed && round < maxTaintRounds); round++ {
+		o.changed = false
+		for _, file := range pass.Files {
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					if fn, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func); ok && decl.Body != nil {
+						o.walk(decl.Body, fn)
+					}
+				case *ast.GenDecl:
+					o.walk(decl, nil)
+				}
+			}
+		}
+	}
+	return o
+}
+
+// lookupOrigins returns the origins of sensitive values of pass, or nil when
+// its security filter is disabled.
+func lookupOrigins(pass *analysis.Pass) *origins {
+	if o, ok := originsByPass.Load(pass); ok {
+		return o.(*origins)
+	}
+	return nil
+}
+
+// exportFacts exports a fact for each function of the package returning a
+// tracked value.
+func (o *origins) exportFacts() {
+	for fn, fact := range o.funcs {
+		o.sources.exportFact(o.pass, fn, fact)
+	}
+}
+
+// walk records the variables assigned a tracked value in node, and the
+// tainted results of fn, the function whose body node is. Function literals
+// are walked with a nil fn.
+func (o *origins) walk(node ast.Node, fn *types.Func) {
+	// blocks is the stack of blocks enclosing the node being inspected.
+	var blocks, stack []ast.Node
+	ast.Inspect(node, func(n ast.Node) bool {
+		if n == nil {
+			switch stack[len(stack)-1].(type) {
+			case *ast.BlockStmt, *ast.CaseClause, *ast.CommClause:
+				blocks = blocks[:len(blocks)-1]
+			}
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		var block ast.Node
+		if len(blocks) > 0 {
+			block = blocks[len(blocks)-1]
+		}
+		stack = append(stack, n)
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			stack = stack[:len(stack)-1]
+			o.walk(n.Body, nil)
+			return false
+		case *ast.BlockStmt, *ast.CaseClause, *ast.CommClause:
+			blocks = append(blocks, n)
+		case *ast.AssignStmt:
+			o.assign(n.Lhs, n.Rhs)
+			o.noteAssigns(n, block, n.Lhs, n.Rhs)
+		case *ast.ValueSpec:
+			lhs := make([]ast.Expr, len(n.Names))
+			for i, name := range n.Names {
+				lhs[i] = name
+			}
+			o.assign(lhs, n.Values)
+			o.noteAssigns(n, block, lhs, n.Values)
+		case *ast.RangeStmt:
+			if n.Value != nil {
+				o.record(n.Value, o.of(n.X))
+			}
+		case *ast.ReturnStmt:
+			if fn == nil {
+				return true
+			}
+			for i, result := range n.Results {
+				if origin := o.of(result); origin != "" {
+					if _, ok := o.funcs[fn]; !ok {
+						o.funcs[fn] = taintFact{Result: i, Origin: origin}
+						o.changed = true
+					}
+				}
+			}
+		}
+		o.sources.node(o, n)
+		return true
+	})
+}
+
+// noteAssigns records the assignments of stmt to the local variables of lhs,
+// made in block, so that each replaces the value assigned before it.
+func (o *origins) noteAssigns(stmt ast.Node, block ast.Node, lhs, rhs []ast.Expr) {
+	if block == nil {
+		return
+	}
+	for i, expr := range lhs {
+		ident, ok := ast.Unparen(expr).(*ast.Ident)
+		if !ok || ident.Name == "_" {
+			continue
+		}
+		obj := o.pass.TypesInfo.ObjectOf(ident)
+		if obj == nil {
+			continue
+		}
+		var value func() string
+		switch {
+		case len(lhs) == len(rhs):
+			value = func() string { return o.of(rhs[i]) }
+		case len(rhs) == 1:
+			call, ok := ast.Unparen(rhs[0]).(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			value = func() string { return o.result(call, i) }
+		default:
+			// var v T declares v with its zero value.
+			value = func() string { return "" }
+		}
+		if o.assigns[obj] == nil {
+			o.assigns[obj] = map[token.Pos]assignment{}
+		}
+		o.assigns[obj][stmt.Pos()] = assignment{end: stmt.End(), block: block, value: value}
+	}
+}
+
+// reaching returns the last assignment to obj before pos made in a block
+// enclosing pos, if any.
+func (o *origins) reaching(obj types.Object, pos token.Pos) (assignment, bool) {
+	var last assignment
+	found := false
+	for _, a := range o.assigns[obj] {
+		if a.end <= pos && a.block.Pos() <= pos && pos < a.block.End() && (!found || a.end > last.end) {
+			last, found = a, true
+		}
+	}
+	return last, found
+}
+
+// assign records the variables of lhs assigned a tracked value of rhs,
+// including the results of a multi-valued call.
+func (o *origins) assign(lhs, rhs []ast.Expr) {
+	if len(lhs) == len(rhs) {
+		for i := range lhs {
+			o.record(lhs[i], o.of(rhs[i]))
+		}
+		return
+	}
+	if len(rhs) == 1 {
+		if call, ok := ast.Unparen(rhs[0]).(*ast.CallExpr); ok {
+			for i := range lhs {
+				o.record(lhs[i], o.result(call, i))
+			}
+		}
+	}
+}
+
+// record notes that the variable expr refers to holds the value origin
+// describes. It keeps the first origin recorded for a variable.
+func (o *origins) record(expr ast.Expr, origin string) {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok || origin == "" || ident.Name == "_" {
+		return
+	}
+	obj := o.pass.TypesInfo.ObjectOf(ident)
+	if obj == nil {
+		return
+	}
+	if _, ok := o.vars[obj]; !ok {
+		o.vars[obj] = origin
+		o.changed = true
+	}
+}
+
+// of describes the tracked value expr evaluates to, or returns "".
+func (o *origins) of(expr ast.Expr) string {
+	info := o.pass.TypesInfo
+	expr = ast.Unparen(expr)
+	switch expr.(type) {
+	case *ast.SelectorExpr, *ast.IndexExpr, *ast.CompositeLit:
+		if origin := o.sources.expr(o, expr); origin != "" {
+			return origin
+		}
+	}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if obj := info.Uses[e]; obj != nil {
+			if a, ok := o.reaching(obj, e.Pos()); ok && o.vars[obj] != "" {
+				return a.value()
+			}
+			return o.vars[obj]
+		}
+	case *ast.SelectorExpr:
+		if _, ok := info.Selections[e]; ok {
+			return ""
+		}
+		// pkg.Var
+		if obj := info.Uses[e.Sel]; obj != nil {
+			return o.vars[obj]
+		}
+	case *ast.IndexExpr:
+		return o.of(e.X)
+	case *ast.SliceExpr:
+		return o.of(e.X)
+	case *ast.StarExpr:
+		return o.of(e.X)
+	case *ast.UnaryExpr:
+		return o.of(e.X)
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return ""
+		}
+		if origin := o.of(e.X); origin != "" {
+			return origin
+		}
+		return o.of(e.Y)
+	case *ast.CallExpr:
+		if tv, ok := info.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return o.of(e.Args[0])
+		}
+		return o.result(e, 0)
+	}
+	return ""
+}
+
+// result describes the tracked value the i-th result of call holds, or
+// returns "".
+func (o *origins) result(call *ast.CallExpr, i int) string {
+	info := o.pass.TypesInfo
+	if o.sanitizers.SanitizerCall(info, call) {
+		return ""
+	}
+	fn, ok := typeutil.Callee(info, call).(*types.Func)
+	if !ok {
+		return ""
+	}
+	fn = fn.Origin()
+	// The sources describe the functions they know better than the facts
+	// inferred from their bodies.
+	if origin, ok := o.sources.call(o, call, fn, i); ok {
+		return origin
+	}
+	fact, ok := o.funcs[fn]
+	if !ok && fn.Pkg() != o.pass.Pkg {
+		fact, ok = o.sources.importFact(o.pass, fn)
+	}
+	if ok {
+		if fact.Result == i {
+			return fact.Origin
+		}
+		return ""
+	}
+
+	// A function of a string-handling package passes the tracked value of
+	// an argument, or of its receiver, on to its results.
+	if fn.Pkg() == nil || !slices.Contains(taintPackages, fn.Pkg().Path()) || !carriesText(fn, i) {
+		return ""
+	}
+	if sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr); ok && fn.Type().(*types.Signature).Recv() != nil {
+		if origin := o.of(sel.X); origin != "" {
+			return origin
+		}
+	}
+	for _, arg := range call.Args {
+		if origin := o.of(arg); origin != "" {
+			return origin
+		}
+	}
+	return ""
+}
+
+// sensitiveSources are the sources of sensitive values: environment
+// variables and request parameters named after a sensitive keyword,
+// credential headers, URL userinfo, HTTP bodies, database DSNs and the
+// configured taint sources.
+type sensitiveSources struct {
+	security   *filters.SecurityFilter
+	configured []string
+}
+
+// newSensitiveSources returns the sources of sensitive values set up by cfg.
+func newSensitiveSources(cfg *config.Config) *sensitiveSources {
+	return &sensitiveSources{
+		security:   &filters.SecurityFilter{ExtraKeywords: cfg.Security.ExtraKeywords},
+		configured: cfg.Security.TaintSources,
+	}
+}
+
+func (s *sensitiveSources) expr(o *origins, expr ast.Expr) string {
+	info := o.pass.TypesInfo
+	switch e := expr.(type) {
+	case *ast.SelectorExpr:
+		// Cookies mostly carry session identifiers and auth tokens, so the
+		// value of any http.Cookie is a source, whatever its name.
+		if e.Sel.Name == "Value" && isNamedType(info.TypeOf(e.X), "net/http", "Cookie") {
+			return "a cookie value"
+		}
+	case *ast.IndexExpr:
+		if isNamedType(info.TypeOf(e.X), "net/http", "Header") {
+			return headerOrigin(info, e.Index)
+		}
+	case *ast.CompositeLit:
+		// A URL's userinfo is a sensitive type on its own and its text is a
+		// source through Userinfo.Password and Userinfo.String; a URL literal
+		// that sets User prints the password as part of the URL.
+		if !isNamedType(info.TypeOf(e), "net/url", "URL") {
+			return ""
+		}
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "User" {
+					return "a URL with userinfo"
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func (s *sensitiveSources) call(o *origins, call *ast.CallExpr, fn *types.Func, i int) (string, bool) {
+	info := o.pass.TypesInfo
+	if slices.Contains(s.configured, fn.FullName()) {
+		if i == 0 {
+			return "the result of " + fn.FullName(), true
+		}
+		return "", true
+	}
+	recv := fn.Type().(*types.Signature).Recv()
+	switch {
+	case recv == nil && (isFunc(info, call, "os", "Getenv") || isFunc(info, call, "os", "LookupEnv") || isFunc(info, call, "syscall", "Getenv")):
+		if name, ok := constString(info, call.Args[0]); ok && i == 0 {
+			if _, ok := s.security.MatchName(name); ok {
+				return "the environment variable " + name, true
+			}
+		}
+	case recv == nil && (isFunc(info, call, "io", "ReadAll") || isFunc(info, call, "io/ioutil", "ReadAll")):
+		if sel, ok := ast.Unparen(call.Args[0]).(*ast.SelectorExpr); ok && sel.Sel.Name == "Body" && i == 0 {
+			t := info.TypeOf(sel.X)
+			if isNamedType(t, "net/http", "Request") || isNamedType(t, "net/http", "Response") {
+				return "an HTTP body read with io.ReadAll", true
+			}
+		}
+	case recv == nil:
+		// Other functions are not sources.
+	case isNamedType(recv.Type(), "net/http", "Header") && (fn.Name() == "Get" || fn.Name() == "Values") && i == 0:
+		if origin := headerOrigin(info, call.Args[0]); origin != "" {
+			return origin, true
+		}
+	case isNamedType(recv.Type(), "net/http", "Request"):
+		switch fn.Name() {
+		case "FormValue", "PostFormValue":
+			if origin := s.parameterOrigin(info, call.Args[0], i); origin != "" {
+				return origin, true
+			}
+		case "BasicAuth":
+			if i == 1 {
+				return "the HTTP basic auth password", true
+			}
+		}
+	case isNamedType(recv.Type(), "net/url", "Values") && fn.Name() == "Get":
+		if origin := s.parameterOrigin(info, call.Args[0], i); origin != "" {
+			return origin, true
+		}
+	case isNamedType(recv.Type(), "net/url", "Userinfo") && (fn.Name() == "Password" || fn.Name() == "String") && i == 0:
+		return "the userinfo of a URL", true
+	}
+	return "", false
+}
+
+func (s *sensitiveSources) node(o *origins, n ast.Node) {
+	info := o.pass.TypesInfo
+	switch n := n.(type) {
+	case *ast.AssignStmt:
+		for _, lhs := range n.Lhs {
+			// u.User = url.UserPassword(...)
+			sel, ok := lhs.(*ast.SelectorExpr)
+			if ok && sel.Sel.Name == "User" && isNamedType(info.TypeOf(sel.X), "net/url", "URL") {
+				o.record(sel.X, "a URL with userinfo")
+			}
+		}
+	case *ast.CallExpr:
+		// sql.Open(driver, dsn)
+		if isFunc(info, n, "database/sql", "Open") && len(n.Args) == 2 {
+			o.record(n.Args[1], "a database/sql DSN")
+		}
+	}
+}
+
+func (s *sensitiveSources) exportFact(pass *analysis.Pass, fn *types.Func, fact taintFact) {
+	pass.ExportObjectFact(fn, &fact)
+}
+
+func (s *sensitiveSources) importFact(pass *analysis.Pass, fn *types.Func) (taintFact, bool) {
+	var fact taintFact
+	ok := pass.ImportObjectFact(fn, &fact)
+	return fact, ok
+}
+
+// parameterOrigin describes a request parameter named by the constant key
+// when the name matches a sensitive keyword.
+func (s *sensitiveSources) parameterOrigin(info *types.Info, key ast.Expr, i int) string {
+	name, ok := constString(info, key)
+	if !ok || i != 0 {
+		return ""
+	}
+	if _, ok := s.security.MatchName(name); !ok {
+		return ""
+	}
+	return fmt.Sprintf("the request parameter %q", name)
+}
+
+// headerOrigin describes the HTTP header named by the constant key when it
+// carries credentials.
+func headerOrigin(info *types.Info, key ast.Expr) string {
+	name, ok := constString(info, key)
+	if !ok {
+		return ""
+	}
+	for _, h := range sensitiveHeaders {
+		if strings.EqualFold(name, h) {
+			return "the " + h + " header"
+		}
+	}
+	return ""
+}
+
+// constString returns the value of expr when it is a string constant.
+func constString(info *types.Info, expr ast.Expr) (string, bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// carriesText reports whether the i-th result of fn is a string, a byte
+// slice, a slice of either, or an error, whose text may repeat an argument.
+func carriesText(fn *types.Func, i int) bool {
+	results := fn.Type().(*types.Signature).Results()
+	if i >= results.Len() {
+		return false
+	}
+	t := results.At(i).Type()
+	if slice, ok := t.Underlying().(*types.Slice); ok {
+		if basic, ok := slice.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte {
+			return true
+		}
+		t = slice.Elem()
+	}
+	if basic, ok := t.Underlying().(*types.Basic); ok {
+		return basic.Info()&types.IsString != 0 || basic.Kind() == types.Byte
+	}
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
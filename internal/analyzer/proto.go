@@ -0,0 +1,240 @@
+package analyzer
+
+import (
+	"encoding/binary"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Field numbers of the descriptor.proto messages read by protoRedacted.
+const (
+	fileMessageType   = 4  // FileDescriptorProto.message_type
+	messageName       = 1  // DescriptorProto.name
+	messageField      = 2  // DescriptorProto.field
+	messageNestedType = 3  // DescriptorProto.nested_type
+	fieldName         = 1  // FieldDescriptorProto.name
+	fieldOptions      = 8  // FieldDescriptorProto.options
+	optionDebugRedact = 16 // FieldOptions.debug_redact
+)
+
+// protoField is a field of a protobuf message declared debug_redact.
+type protoField struct {
+	// GoType is the name of the generated Go type of the message.
+	GoType string
+	// Name is the name of the field in the .proto file.
+	Name string
+}
+
+// protoRedacted returns the fields of the protobuf messages generated in the
+// package under analysis that are declared with [debug_redact = true], read
+// from the file descriptors protoc-gen-go embeds as file_*_rawDesc.
+func protoRedacted(pass *analysis.Pass) map[*types.Var]bool {
+	redacted := map[*types.Var]bool{}
+	for _, desc := range rawDescriptors(pass) {
+		var fields []protoField
+		if !parseFileDescriptor(desc, &fields) {
+			continue
+		}
+		for _, pf := range fields {
+			if v := generatedField(pass.Pkg, pf); v != nil {
+				redacted[v] = true
+			}
+		}
+	}
+	return redacted
+}
+
+// rawDescriptors returns the serialised file descriptors of the package: the
+// string constants and byte slice literals named file_*_rawDesc.
+func rawDescriptors(pass *analysis.Pass) [][]byte {
+	var descs [][]byte
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || (gen.Tok != token.CONST && gen.Tok != token.VAR) {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if !strings.HasPrefix(name.Name, "file_") || !strings.HasSuffix(name.Name, "_rawDesc") || i >= len(vs.Values) {
+						continue
+					}
+					if desc, ok := constBytes(pass.TypesInfo, vs.Values[i]); ok {
+						descs = append(descs, desc)
+					}
+				}
+			}
+		}
+	}
+	return descs
+}
+
+// constBytes returns the value of expr, a string constant or a []byte
+// literal of constant elements.
+func constBytes(info *types.Info, expr ast.Expr) ([]byte, bool) {
+	if tv, ok := info.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return []byte(constant.StringVal(tv.Value)), true
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil, false
+	}
+	b := make([]byte, 0, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		tv, ok := info.Types[elt]
+		if !ok || tv.Value == nil {
+			return nil, false
+		}
+		v, ok := constant.Uint64Val(constant.ToInt(tv.Value))
+		if !ok || v > 0xff {
+			return nil, false
+		}
+		b = append(b, byte(v))
+	}
+	return b, true
+}
+
+// generatedField returns the field of the Go struct generated for pf, found
+// by the proto name recorded in its protobuf struct tag.
+func generatedField(pkg *types.Package, pf protoField) *types.Var {
+	obj, ok := pkg.Scope().Lookup(pf.GoType).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+	st, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		for _, opt := range strings.Split(reflect.StructTag(st.Tag(i)).Get("protobuf"), ",") {
+			if opt == "name="+pf.Name {
+				return st.Field(i)
+			}
+		}
+	}
+	return nil
+}
+
+// parseFileDescriptor appends the debug_redact fields of the messages of a
+// serialised FileDescriptorProto to fields. It reports false when b is not a
+// well-formed message.
+func parseFileDescriptor(b []byte, fields *[]protoField) bool {
+	return walkWire(b, func(num int, value []byte) bool {
+		if num == fileMessageType {
+			return parseMessage(value, "", fields)
+		}
+		return true
+	})
+}
+
+// parseMessage appends the debug_redact fields of a serialised
+// DescriptorProto, and of its nested messages, to fields. prefix is the Go
+// name of the enclosing message followed by an underscore.
+func parseMessage(b []byte, prefix string, fields *[]protoField) bool {
+	var name string
+	var redacted []string
+	var nested [][]byte
+	ok := walkWire(b, func(num int, value []byte) bool {
+		switch num {
+		case messageName:
+			name = string(value)
+		case messageField:
+			if fname, ok := parseField(value); ok && fname != "" {
+				redacted = append(redacted, fname)
+			}
+		case messageNestedType:
+			nested = append(nested, value)
+		}
+		return true
+	})
+	if !ok || name == "" {
+		return false
+	}
+	goType := prefix + name
+	for _, fname := range redacted {
+		*fields = append(*fields, protoField{GoType: goType, Name: fname})
+	}
+	for _, n := range nested {
+		if !parseMessage(n, goType+"_", fields) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseField returns the name of a serialised FieldDescriptorProto when its
+// options set debug_redact, or "" otherwise.
+func parseField(b []byte) (string, bool) {
+	var name string
+	var redact bool
+	ok := walkWire(b, func(num int, value []byte) bool {
+		switch num {
+		case fieldName:
+			name = string(value)
+		case fieldOptions:
+			return walkWire(value, func(num int, value []byte) bool {
+				if num == optionDebugRedact {
+					v, n := binary.Uvarint(value)
+					redact = n > 0 && v != 0
+				}
+				return true
+			})
+		}
+		return true
+	})
+	if !ok || !redact {
+		return "", ok
+	}
+	return name, true
+}
+
+// walkWire calls fn for each field of the protobuf wire-format message b with
+// its number and its payload: the varint bytes, fixed bytes or delimited
+// contents. It reports false when b is malformed, uses groups, or fn does.
+func walkWire(b []byte, fn func(num int, value []byte) bool) bool {
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return false
+		}
+		b = b[n:]
+		var value []byte
+		switch key & 7 {
+		case 0: // varint
+			_, n := binary.Uvarint(b)
+			if n <= 0 {
+				return false
+			}
+			value, b = b[:n], b[n:]
+		case 1: // fixed64
+			if len(b) < 8 {
+				return false
+			}
+			value, b = b[:8], b[8:]
+		case 2: // length-delimited
+			size, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < size {
+				return false
+			}
+			value, b = b[n:n+int(size)], b[n+int(size):]
+		case 5: // fixed32
+			if len(b) < 4 {
+				return false
+			}
+			value, b = b[:4], b[4:]
+		default:
+			return false
+		}
+		if !fn(int(key>>3), value) {
+			return false
+		}
+	}
+	return true
+}
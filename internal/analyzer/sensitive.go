@@ -0,0 +1,276 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/PriestFaria/lingo/internal/config"
+	"github.com/PriestFaria/lingo/internal/filters"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+// sensitiveDirective is the comment that marks a named type as sensitive.
+const sensitiveDirective = "//lingo:sensitive"
+
+// sensitiveFact is exported for a named type annotated with a
+// //lingo:sensitive comment and for a struct field tagged lingo:"secret", so
+// logging a value that holds it is reported in any package.
+type sensitiveFact struct{}
+
+func (*sensitiveFact) AFact() {}
+
+func (*sensitiveFact) String() string { return "sensitive" }
+
+// redactingFact is exported for a named type holding sensitive fields whose
+// String, LogValue or MarshalLogObject method does not reference them, so its
+// values can be logged.
+type redactingFact struct {
+	// Method is the name of the redacting method.
+	Method string
+}
+
+func (*redactingFact) AFact() {}
+
+func (f *redactingFact) String() string { return "redacts(" + f.Method + ")" }
+
+// redactingMethods are the methods through which loggers format a value.
+var redactingMethods = []string{"String", "LogValue", "MarshalLogObject"}
+
+// exportSensitiveFacts exports a sensitiveFact for the annotated types and
+// tagged fields of the package under analysis, and for the debug_redact fields
+// of its generated protobuf messages, then a redactingFact for each of its
+// types that formats itself without those fields.
+func exportSensitiveFacts(pass *analysis.Pass, cfg *config.Config) {
+	for v := range protoRedacted(pass) {
+		pass.ExportObjectFact(v, new(sensitiveFact))
+	}
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.GenDecl:
+				if n.Tok != token.TYPE {
+					return true
+				}
+				for _, spec := range n.Specs {
+					ts := spec.(*ast.TypeSpec)
+					doc := ts.Doc
+					if doc == nil && len(n.Specs) == 1 {
+						doc = n.Doc
+					}
+					if !hasSensitiveDirective(doc) {
+						continue
+					}
+					if obj := pass.TypesInfo.Defs[ts.Name]; obj != nil {
+						pass.ExportObjectFact(obj, new(sensitiveFact))
+					}
+				}
+			case *ast.StructType:
+				st, ok := pass.TypesInfo.TypeOf(n).(*types.Struct)
+				if !ok {
+					return true
+				}
+				for i := 0; i < st.NumFields(); i++ {
+					if isSecretTag(st.Tag(i), cfg.Security.InferJSONDash) {
+						pass.ExportObjectFact(st.Field(i), new(sensitiveFact))
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	marks := sensitiveMarks{pass: pass}
+	if cfg.Filters.IsEnabled("security") {
+		marks.names = &filters.SecurityFilter{ExtraKeywords: cfg.Security.ExtraKeywords, Sanitizers: cfg.Security.Sanitizers}
+	}
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || fd.Body == nil || !slices.Contains(redactingMethods, fd.Name.Name) {
+				continue
+			}
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+			recv := fn.Type().(*types.Signature).Recv().Type()
+			if ptr, ok := recv.(*types.Pointer); ok {
+				recv = ptr.Elem()
+			}
+			named, ok := recv.(*types.Named)
+			// The generated String method of a protobuf message prints all
+			// of its fields, whatever its body references.
+			if !ok || isProtoMessage(named) || !marks.holdsSensitive(named.Underlying(), map[types.Type]bool{}) || marks.referencesSensitive(fd.Body) {
+				continue
+			}
+			pass.ExportObjectFact(named.Obj(), &redactingFact{Method: fd.Name.Name})
+		}
+	}
+}
+
+// isProtoMessage reports whether t is a struct generated by protoc-gen-go: one
+// with fields tagged protobuf or protobuf_oneof.
+func isProtoMessage(t *types.Named) bool {
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		tag := reflect.StructTag(st.Tag(i))
+		if _, ok := tag.Lookup("protobuf"); ok {
+			return true
+		}
+		if _, ok := tag.Lookup("protobuf_oneof"); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSensitiveDirective reports whether doc contains a //lingo:sensitive line.
+func hasSensitiveDirective(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		if strings.TrimSpace(c.Text) == sensitiveDirective {
+			return true
+		}
+	}
+	return false
+}
+
+// isSecretTag reports whether a struct tag marks its field as sensitive:
+// lingo:"secret", or json:"-" when inferJSONDash is set.
+func isSecretTag(tag string, inferJSONDash bool) bool {
+	st := reflect.StructTag(tag)
+	if value, ok := st.Lookup("lingo"); ok && slices.Contains(strings.Split(value, ","), "secret") {
+		return true
+	}
+	return inferJSONDash && st.Get("json") == "-"
+}
+
+// sensitiveMarks implements filters.SensitiveMarks with the facts of pass.
+type sensitiveMarks struct {
+	pass *analysis.Pass
+	// names, when set, also makes fields named after a sensitive keyword
+	// count as sensitive in holdsSensitive and referencesSensitive, and the
+	// arguments of its sanitizers not count as references.
+	names *filters.SecurityFilter
+}
+
+func (m sensitiveMarks) Sensitive(obj types.Object) bool {
+	if v, ok := obj.(*types.Var); ok {
+		obj = v.Origin()
+	}
+	return m.pass.ImportObjectFact(obj, new(sensitiveFact))
+}
+
+func (m sensitiveMarks) Redacts(obj *types.TypeName) bool {
+	return m.pass.ImportObjectFact(obj, new(redactingFact))
+}
+
+// sensitiveField reports whether the field f is marked or, when m.names is
+// set, is a field of a struct of the package named after a sensitive keyword.
+func (m sensitiveMarks) sensitiveField(f *types.Var) bool {
+	if m.Sensitive(f) {
+		return true
+	}
+	if m.names == nil || f.Pkg() != m.pass.Pkg {
+		return false
+	}
+	_, ok := m.names.MatchName(f.Name())
+	return ok
+}
+
+// holdsSensitive reports whether t is a struct with a sensitive field, or a
+// field of a sensitive type, at any depth.
+func (m sensitiveMarks) holdsSensitive(t types.Type, seen map[types.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		ft := f.Type()
+		if ptr, ok := ft.(*types.Pointer); ok {
+			ft = ptr.Elem()
+		}
+		if named, ok := ft.(*types.Named); ok && m.Sensitive(named.Obj()) {
+			return true
+		}
+		if m.sensitiveField(f) || m.holdsSensitive(ft, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// referencesSensitive reports whether body selects a sensitive field or a
+// value of a sensitive type outside the arguments of a sanitizer.
+func (m sensitiveMarks) referencesSensitive(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && m.names != nil && m.names.SanitizerCall(m.pass.TypesInfo, call) {
+			return false
+		}
+		sel, ok := n.(*ast.SelectorExpr)
+		if found || !ok {
+			return !found
+		}
+		if v, ok := m.pass.TypesInfo.Uses[sel.Sel].(*types.Var); ok && v.IsField() && m.sensitiveField(v) {
+			found = true
+		}
+		if named, ok := m.pass.TypesInfo.TypeOf(sel).(*types.Named); ok && m.Sensitive(named.Obj()) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// Marks runs the analysis configured by cfg over pkg, loaded with
+// packages.LoadAllSyntax, and returns what it marks as sensitive in pkg and
+// its dependencies: the annotated types, the tagged fields, the debug_redact
+// fields of protobuf messages and the types that redact themselves. It lets
+// tools outside an analysis pass, such as gen-redact, classify fields as the
+// analyzer does.
+func Marks(pkg *packages.Package, cfg *config.Config) (filters.SensitiveMarks, error) {
+	graph, err := checker.Analyze([]*analysis.Analyzer{NewAnalyzerWithConfig(cfg)}, []*packages.Package{pkg}, nil)
+	if err != nil {
+		return nil, err
+	}
+	act := graph.Roots[0]
+	if act.Err != nil {
+		return nil, act.Err
+	}
+	return actionMarks{act: act}, nil
+}
+
+// actionMarks implements filters.SensitiveMarks with the facts of a
+// completed analysis.
+type actionMarks struct {
+	act *checker.Action
+}
+
+func (m actionMarks) Sensitive(obj types.Object) bool {
+	if v, ok := obj.(*types.Var); ok {
+		obj = v.Origin()
+	}
+	return m.act.ObjectFact(obj, new(sensitiveFact))
+}
+
+func (m actionMarks) Redacts(obj *types.TypeName) bool {
+	return m.act.ObjectFact(obj, new(redactingFact))
+}
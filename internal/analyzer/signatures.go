@@ -0,0 +1,486 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/PriestFaria/lingo/internal/config"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// callKind classifies a function of a supported logging API.
+type callKind int
+
+const (
+	// emitCall writes a log record; its message is run through every filter.
+	emitCall callKind = iota
+	// attrCall builds an attribute or a derived logger; its arguments are only
+	// run through the sensitive-data checks.
+	attrCall
+)
+
+// noArg marks an absent argument index in a logFunc.
+const noArg = -1
+
+// logFunc describes how the arguments of a logging function are laid out.
+type logFunc struct {
+	Kind callKind
+	// Msg is the index of the message (or format string) argument, or noArg.
+	Msg int
+	// Format is true when the message is a printf-style template and the
+	// following arguments are its operands.
+	Format bool
+	// Print is true when every argument from Msg onward is part of the
+	// message, as in fmt.Sprint / fmt.Sprintln.
+	Print bool
+	// Args is the index of the first key/value, field or attribute argument,
+	// or noArg. Arguments of an attrCall before Args are checked on their own.
+	Args int
+	// Level is the severity implied by the function name (e.g. "info"), or
+	// empty when the level is an argument or unknown.
+	Level string
+}
+
+// apiFunc identifies a function or method within a logging package.
+type apiFunc struct {
+	// Recv is the receiver type name, empty for package-level functions.
+	Recv string
+	Name string
+}
+
+// logAPIs maps an import path to the table of its recognised functions.
+// Functions missing from a table are not log calls and are ignored.
+var logAPIs = map[string]map[apiFunc]logFunc{
+	"log":             stdlogAPI(),
+	"log/slog":        slogAPI(),
+	"go.uber.org/zap": zapAPI(),
+
+	"github.com/sirupsen/logrus": logrusAPI(),
+	"github.com/rs/zerolog":      zerologAPI(),
+	"github.com/rs/zerolog/log":  zerologLogAPI(),
+	"github.com/go-logr/logr":    logrAPI(),
+	"k8s.io/klog/v2":             klogAPI(),
+
+	"github.com/hashicorp/go-hclog": hclogAPI(),
+	"github.com/go-kit/log":         gokitAPI(),
+	"github.com/go-kit/kit/log":     gokitAPI(),
+}
+
+// stdlogAPI describes the standard library "log" package.
+func stdlogAPI() map[apiFunc]logFunc {
+	api := map[apiFunc]logFunc{}
+	for _, recv := range []string{"", "Logger"} {
+		for _, prefix := range []string{"Print", "Fatal", "Panic"} {
+			api[apiFunc{recv, prefix}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: levelName(prefix)}
+			api[apiFunc{recv, prefix + "ln"}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: levelName(prefix)}
+			api[apiFunc{recv, prefix + "f"}] = logFunc{Kind: emitCall, Msg: 0, Format: true, Args: noArg, Level: levelName(prefix)}
+		}
+		// Output(calldepth int, s string)
+		api[apiFunc{recv, "Output"}] = logFunc{Kind: emitCall, Msg: 1, Args: noArg}
+	}
+	return api
+}
+
+// slogAPI describes the "log/slog" package.
+func slogAPI() map[apiFunc]logFunc {
+	api := map[apiFunc]logFunc{}
+	for _, recv := range []string{"", "Logger"} {
+		for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+			api[apiFunc{recv, level}] = logFunc{Kind: emitCall, Msg: 0, Args: 1, Level: levelName(level)}
+			api[apiFunc{recv, level + "Context"}] = logFunc{Kind: emitCall, Msg: 1, Args: 2, Level: levelName(level)}
+		}
+		// Log(ctx, level, msg, args...) and LogAttrs(ctx, level, msg, attrs...)
+		api[apiFunc{recv, "Log"}] = logFunc{Kind: emitCall, Msg: 2, Args: 3}
+		api[apiFunc{recv, "LogAttrs"}] = logFunc{Kind: emitCall, Msg: 2, Args: 3}
+		api[apiFunc{recv, "With"}] = logFunc{Kind: attrCall, Msg: noArg, Args: 0}
+	}
+	// Attribute constructors take a (key, value) pair; Group(key, args...)
+	// takes its name followed by key/value pairs or attributes.
+	for _, name := range []string{
+		"String", "Int", "Int64", "Uint64", "Float64", "Bool",
+		"Time", "Duration", "Any",
+	} {
+		api[apiFunc{"", name}] = logFunc{Kind: attrCall, Msg: noArg, Args: 0}
+	}
+	api[apiFunc{"", "Group"}] = logFunc{Kind: attrCall, Msg: noArg, Args: 1}
+	return api
+}
+
+// zapAPI describes "go.uber.org/zap": Logger, SugaredLogger and the Field
+// constructors.
+func zapAPI() map[apiFunc]logFunc {
+	api := map[apiFunc]logFunc{}
+	for _, level := range []string{"Debug", "Info", "Warn", "Error", "DPanic", "Panic", "Fatal"} {
+		api[apiFunc{"Logger", level}] = logFunc{Kind: emitCall, Msg: 0, Args: 1, Level: levelName(level)}
+
+		api[apiFunc{"SugaredLogger", level}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: levelName(level)}
+		api[apiFunc{"SugaredLogger", level + "ln"}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: levelName(level)}
+		api[apiFunc{"SugaredLogger", level + "f"}] = logFunc{Kind: emitCall, Msg: 0, Format: true, Args: noArg, Level: levelName(level)}
+		api[apiFunc{"SugaredLogger", level + "w"}] = logFunc{Kind: emitCall, Msg: 0, Args: 1, Level: levelName(level)}
+	}
+	// Log(lvl, msg, fields...) and Check(lvl, msg)
+	api[apiFunc{"Logger", "Log"}] = logFunc{Kind: emitCall, Msg: 1, Args: 2}
+	api[apiFunc{"Logger", "Check"}] = logFunc{Kind: emitCall, Msg: 1, Args: noArg}
+	api[apiFunc{"Logger", "With"}] = logFunc{Kind: attrCall, Msg: noArg, Args: 0}
+
+	// Level-first SugaredLogger variants: Log(lvl, args...), Logf(lvl, template, args...), ...
+	api[apiFunc{"SugaredLogger", "Log"}] = logFunc{Kind: emitCall, Msg: 1, Print: true, Args: noArg}
+	api[apiFunc{"SugaredLogger", "Logln"}] = logFunc{Kind: emitCall, Msg: 1, Print: true, Args: noArg}
+	api[apiFunc{"SugaredLogger", "Logf"}] = logFunc{Kind: emitCall, Msg: 1, Format: true, Args: noArg}
+	api[apiFunc{"SugaredLogger", "Logw"}] = logFunc{Kind: emitCall, Msg: 1, Args: 2}
+	api[apiFunc{"SugaredLogger", "With"}] = logFunc{Kind: attrCall, Msg: noArg, Args: 0}
+
+	// Field constructors taking a (key, value) pair.
+	for _, name := range []string{
+		"String", "Strings", "Stringer", "ByteString", "Binary",
+		"Int", "Int64", "Int32", "Uint", "Uint64", "Float64", "Bool",
+		"Time", "Duration", "NamedError",
+		"Any", "Reflect", "Object", "Array",
+	} {
+		api[apiFunc{"", name}] = logFunc{Kind: attrCall, Msg: noArg, Args: 0}
+	}
+	// Namespace(key), Inline(val) and Error(err) take a single argument;
+	// Dict(key, fields...) takes its name followed by fields.
+	for _, name := range []string{"Namespace", "Inline", "Error"} {
+		api[apiFunc{"", name}] = logFunc{Kind: attrCall, Msg: noArg, Args: noArg}
+	}
+	api[apiFunc{"", "Dict"}] = logFunc{Kind: attrCall, Msg: noArg, Args: 1}
+	return api
+}
+
+// logrusAPI describes "github.com/sirupsen/logrus": the package-level
+// functions and the methods of *Logger and *Entry.
+func logrusAPI() map[apiFunc]logFunc {
+	api := map[apiFunc]logFunc{}
+	for _, recv := range []string{"", "Logger", "Entry"} {
+		for _, level := range []string{"Trace", "Debug", "Info", "Print", "Warn", "Warning", "Error", "Fatal", "Panic"} {
+			api[apiFunc{recv, level}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: levelName(level)}
+			api[apiFunc{recv, level + "ln"}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: levelName(level)}
+			api[apiFunc{recv, level + "f"}] = logFunc{Kind: emitCall, Msg: 0, Format: true, Args: noArg, Level: levelName(level)}
+		}
+		// WithField(key, value), WithFields(fields) and WithError(err)
+		api[apiFunc{recv, "WithField"}] = logFunc{Kind: attrCall, Msg: noArg, Args: 0}
+		api[apiFunc{recv, "WithFields"}] = logFunc{Kind: attrCall, Msg: noArg, Args: 0}
+		api[apiFunc{recv, "WithError"}] = logFunc{Kind: attrCall, Msg: noArg, Args: noArg}
+	}
+	// Level-first variants: Log(level, args...), Logf(level, format, args...), ...
+	for _, recv := range []string{"Logger", "Entry"} {
+		api[apiFunc{recv, "Log"}] = logFunc{Kind: emitCall, Msg: 1, Print: true, Args: noArg}
+		api[apiFunc{recv, "Logln"}] = logFunc{Kind: emitCall, Msg: 1, Print: true, Args: noArg}
+		api[apiFunc{recv, "Logf"}] = logFunc{Kind: emitCall, Msg: 1, Format: true, Args: noArg}
+	}
+	return api
+}
+
+// zerologFields describes the field methods shared by zerolog's *Event and
+// Context, keyed by method name. Event fields are not listed in logAPIs: they
+// are collected by walking the chain back from the terminal Msg/Msgf/Send.
+var zerologFields = zerologFieldsAPI()
+
+func zerologFieldsAPI() map[string]logFunc {
+	fields := map[string]logFunc{}
+	// Methods taking a (key, value) pair.
+	for _, name := range []string{
+		"Str", "Strs", "Stringer", "Stringers", "Bytes", "Hex", "RawJSON",
+		"Int", "Ints", "Int8", "Int16", "Int32", "Int64",
+		"Uint", "Uints", "Uint8", "Uint16", "Uint32", "Uint64",
+		"Float32", "Float64", "Bool", "Bools", "Time", "Dur",
+		"Interface", "Any", "Object", "Array", "AnErr", "Errs",
+		"IPAddr", "IPPrefix", "MACAddr",
+	} {
+		fields[name] = logFunc{Kind: attrCall, Msg: noArg, Args: 0}
+	}
+	// Err(err), EmbedObject(obj) and Fields(fields) take a single value;
+	// Dict(key, dict) takes its name followed by a nested field chain.
+	for _, name := range []string{"Err", "EmbedObject", "Fields"} {
+		fields[name] = logFunc{Kind: attrCall, Msg: noArg, Args: noArg}
+	}
+	fields["Dict"] = logFunc{Kind: attrCall, Msg: noArg, Args: 1}
+	return fields
+}
+
+// zerologAPI describes "github.com/rs/zerolog": the terminal methods of
+// *Event, Logger.Print/Printf and the field methods of Context.
+func zerologAPI() map[apiFunc]logFunc {
+	api := map[apiFunc]logFunc{
+		{"Event", "Msg"}:     {Kind: emitCall, Msg: 0, Args: noArg},
+		{"Event", "Msgf"}:    {Kind: emitCall, Msg: 0, Format: true, Args: noArg},
+		{"Event", "Send"}:    {Kind: emitCall, Msg: noArg, Args: noArg},
+		{"Logger", "Print"}:  {Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: "debug"},
+		{"Logger", "Printf"}: {Kind: emitCall, Msg: 0, Format: true, Args: noArg, Level: "debug"},
+	}
+	for name, fn := range zerologFields {
+		api[apiFunc{"Context", name}] = fn
+	}
+	return api
+}
+
+// zerologLogAPI describes "github.com/rs/zerolog/log", the global logger. Its
+// level functions return events that end up in the *Event terminals above.
+func zerologLogAPI() map[apiFunc]logFunc {
+	return map[apiFunc]logFunc{
+		{"", "Print"}:  {Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: "debug"},
+		{"", "Printf"}: {Kind: emitCall, Msg: 0, Format: true, Args: noArg, Level: "debug"},
+	}
+}
+
+// logrAPI describes "github.com/go-logr/logr". V(n) returns another Logger,
+// so verbosity chains such as log.V(2).Info(msg) resolve to these methods.
+func logrAPI() map[apiFunc]logFunc {
+	return map[apiFunc]logFunc{
+		{"Logger", "Info"}:       {Kind: emitCall, Msg: 0, Args: 1, Level: "info"},
+		{"Logger", "Error"}:      {Kind: emitCall, Msg: 1, Args: 2, Level: "error"},
+		{"Logger", "WithValues"}: {Kind: attrCall, Msg: noArg, Args: 0},
+	}
+}
+
+// klogAPI describes "k8s.io/klog/v2": the package-level functions and the
+// methods of Verbose, returned by klog.V(n).
+func klogAPI() map[apiFunc]logFunc {
+	api := map[apiFunc]logFunc{}
+	for _, level := range []string{"Info", "Warning", "Error", "Fatal", "Exit"} {
+		lvl := levelName(level)
+		if level == "Exit" {
+			lvl = "fatal"
+		}
+		api[apiFunc{"", level}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: lvl}
+		api[apiFunc{"", level + "ln"}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: lvl}
+		api[apiFunc{"", level + "f"}] = logFunc{Kind: emitCall, Msg: 0, Format: true, Args: noArg, Level: lvl}
+		// InfoDepth(depth, args...), InfofDepth(depth, format, args...), ...
+		api[apiFunc{"", level + "Depth"}] = logFunc{Kind: emitCall, Msg: 1, Print: true, Args: noArg, Level: lvl}
+		api[apiFunc{"", level + "lnDepth"}] = logFunc{Kind: emitCall, Msg: 1, Print: true, Args: noArg, Level: lvl}
+		api[apiFunc{"", level + "fDepth"}] = logFunc{Kind: emitCall, Msg: 1, Format: true, Args: noArg, Level: lvl}
+	}
+	for _, recv := range []string{"", "Verbose"} {
+		// InfoS(msg, kv...), ErrorS(err, msg, kv...) and their Depth variants.
+		api[apiFunc{recv, "InfoS"}] = logFunc{Kind: emitCall, Msg: 0, Args: 1, Level: "info"}
+		api[apiFunc{recv, "ErrorS"}] = logFunc{Kind: emitCall, Msg: 1, Args: 2, Level: "error"}
+		api[apiFunc{recv, "InfoSDepth"}] = logFunc{Kind: emitCall, Msg: 1, Args: 2, Level: "info"}
+		api[apiFunc{recv, "ErrorSDepth"}] = logFunc{Kind: emitCall, Msg: 2, Args: 3, Level: "error"}
+	}
+	api[apiFunc{"Verbose", "Info"}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: "info"}
+	api[apiFunc{"Verbose", "Infoln"}] = logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: "info"}
+	api[apiFunc{"Verbose", "Infof"}] = logFunc{Kind: emitCall, Msg: 0, Format: true, Args: noArg, Level: "info"}
+	return api
+}
+
+// hclogAPI describes "github.com/hashicorp/go-hclog", whose Logger is an
+// interface; calls through it resolve to the interface methods.
+func hclogAPI() map[apiFunc]logFunc {
+	api := map[apiFunc]logFunc{}
+	for _, level := range []string{"Trace", "Debug", "Info", "Warn", "Error"} {
+		api[apiFunc{"Logger", level}] = logFunc{Kind: emitCall, Msg: 0, Args: 1, Level: levelName(level)}
+	}
+	// Log(level, msg, args...)
+	api[apiFunc{"Logger", "Log"}] = logFunc{Kind: emitCall, Msg: 1, Args: 2}
+	api[apiFunc{"Logger", "With"}] = logFunc{Kind: attrCall, Msg: noArg, Args: 0}
+	api[apiFunc{"Logger", "Named"}] = logFunc{Kind: attrCall, Msg: noArg, Args: noArg}
+	return api
+}
+
+// gokitAPI describes go-kit's log package. Logger.Log takes only key/value
+// pairs; the message is the value following the "msg" key.
+func gokitAPI() map[apiFunc]logFunc {
+	return map[apiFunc]logFunc{
+		{"Logger", "Log"}: {Kind: emitCall, Msg: noArg, Args: 0},
+		// With(logger, keyvals...), WithPrefix and WithSuffix
+		{"", "With"}:       {Kind: attrCall, Msg: noArg, Args: 1},
+		{"", "WithPrefix"}: {Kind: attrCall, Msg: noArg, Args: 1},
+		{"", "WithSuffix"}: {Kind: attrCall, Msg: noArg, Args: 1},
+	}
+}
+
+// levelName normalises a level method name such as "Info" or "Warning" to a
+// lowercase severity. "Print" has no inherent level.
+func levelName(name string) string {
+	switch name {
+	case "Print":
+		return ""
+	case "Warning":
+		return "warn"
+	}
+	return strings.ToLower(name)
+}
+
+// resolveLogFunc looks up the callee of call in logAPIs and returns its import
+// path and description. ok is false for calls that are not part of a
+// supported logging API.
+func resolveLogFunc(info *types.Info, call *ast.CallExpr) (pkgPath string, fn logFunc, ok bool) {
+	callee, isFunc := typeutil.Callee(info, call).(*types.Func)
+	if !isFunc || callee.Pkg() == nil {
+		return "", logFunc{}, false
+	}
+	pkgPath = callee.Pkg().Path()
+	api, known := logAPIs[pkgPath]
+	if !known {
+		return "", logFunc{}, false
+	}
+	fn, ok = api[apiFunc{Recv: receiverName(callee), Name: callee.Name()}]
+	return pkgPath, fn, ok
+}
+
+// resolveCustomLogFunc matches the callee of call against the custom loggers
+// declared in the configuration and describes it as an emitting call.
+func resolveCustomLogFunc(info *types.Info, call *ast.CallExpr, loggers []config.LoggerConfig) (logFunc, bool) {
+	if len(loggers) == 0 {
+		return logFunc{}, false
+	}
+	callee, isFunc := typeutil.Callee(info, call).(*types.Func)
+	if !isFunc || callee.Pkg() == nil {
+		return logFunc{}, false
+	}
+	recv := receiverName(callee)
+	for i := range loggers {
+		l := &loggers[i]
+		if !l.Matches(callee.Pkg().Path(), recv, callee.Name()) {
+			continue
+		}
+		fn := logFunc{Kind: emitCall, Msg: l.MessageIndex, Format: l.Printf, Args: noArg}
+		if l.ArgsIndex != nil {
+			fn.Args = *l.ArgsIndex
+		}
+		return fn, true
+	}
+	return logFunc{}, false
+}
+
+// logLevels are the method names that, on an interface with a logger-shaped
+// signature, identify a log call.
+var logLevels = map[string]bool{
+	"Trace": true, "Debug": true, "Info": true, "Print": true, "Warn": true,
+	"Warning": true, "Error": true, "Fatal": true, "Panic": true,
+}
+
+// nonLoggerInterfaces lists packages whose interfaces have logger-shaped
+// methods that are not log calls, such as testing.TB's Errorf and Fatal or
+// the TestingT and TestReporter interfaces of testify and gomock.
+var nonLoggerInterfaces = map[string]bool{
+	"testing":                             true,
+	"github.com/stretchr/testify/assert":  true,
+	"github.com/stretchr/testify/require": true,
+	"github.com/stretchr/testify/mock":    true,
+	"github.com/golang/mock/gomock":       true,
+	"go.uber.org/mock/gomock":             true,
+}
+
+// resolveInterfaceLogFunc describes a call through a method of an interface
+// declared in the analysed module (typically a project's own Logger
+// interface) when the method has the shape of a known logging API:
+//
+//	Info(msg string, keysAndValues ...any)         // slog, logr, hclog
+//	InfoContext(ctx context.Context, msg string, args ...any)
+//	Infof(format string, args ...any)              // printf-style
+//	Info(args ...any), Infoln(args ...any)         // print-style
+//	Infow(msg string, keysAndValues ...any)        // zap sugared
+//	Error(err error, msg string, keysAndValues ...any) // logr
+//
+// Interfaces of other modules are matched only when declared as custom
+// loggers. When the driver does not tell the module of the package, as go vet
+// and GOPATH mode do not, any interface outside nonLoggerInterfaces is matched.
+func resolveInterfaceLogFunc(pass *analysis.Pass, call *ast.CallExpr) (logFunc, bool) {
+	callee, isFunc := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !isFunc || callee.Pkg() == nil || nonLoggerInterfaces[callee.Pkg().Path()] || !inModule(pass, callee.Pkg().Path()) {
+		return logFunc{}, false
+	}
+	sig := callee.Type().(*types.Signature)
+	if sig.Recv() == nil || !types.IsInterface(sig.Recv().Type()) {
+		return logFunc{}, false
+	}
+	return loggerShape(callee.Name(), sig)
+}
+
+// inModule reports whether the package pkgPath belongs to the module of the
+// package analysed by pass, or whether that module is unknown.
+func inModule(pass *analysis.Pass, pkgPath string) bool {
+	if pass.Module == nil || pass.Module.Path == "" {
+		return true
+	}
+	return pkgPath == pass.Module.Path || strings.HasPrefix(pkgPath, pass.Module.Path+"/")
+}
+
+// loggerShape matches a method name and signature against the logger shapes
+// listed on resolveInterfaceLogFunc.
+func loggerShape(name string, sig *types.Signature) (logFunc, bool) {
+	var shape []byte
+	for i := 0; i < sig.Params().Len(); i++ {
+		t := sig.Params().At(i).Type()
+		switch {
+		case sig.Variadic() && i == sig.Params().Len()-1:
+			if elem := t.(*types.Slice).Elem(); !isEmptyInterface(elem) {
+				return logFunc{}, false
+			}
+			shape = append(shape, 'v')
+		case isStringType(t):
+			shape = append(shape, 's')
+		case isNamedType(t, "context", "Context"):
+			shape = append(shape, 'c')
+		case types.Identical(t, types.Universe.Lookup("error").Type()):
+			shape = append(shape, 'e')
+		default:
+			return logFunc{}, false
+		}
+	}
+
+	trimmed := func(suffix string) (string, bool) {
+		base, ok := strings.CutSuffix(name, suffix)
+		return base, ok && logLevels[base]
+	}
+	switch string(shape) {
+	case "sv", "s":
+		if logLevels[name] {
+			fn := logFunc{Kind: emitCall, Msg: 0, Args: noArg, Level: levelName(name)}
+			if len(shape) == 2 {
+				fn.Args = 1
+			}
+			return fn, true
+		}
+		if base, ok := trimmed("f"); ok && len(shape) == 2 {
+			return logFunc{Kind: emitCall, Msg: 0, Format: true, Args: noArg, Level: levelName(base)}, true
+		}
+		if base, ok := trimmed("w"); ok && len(shape) == 2 {
+			return logFunc{Kind: emitCall, Msg: 0, Args: 1, Level: levelName(base)}, true
+		}
+	case "v":
+		if logLevels[name] {
+			return logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: levelName(name)}, true
+		}
+		if base, ok := trimmed("ln"); ok {
+			return logFunc{Kind: emitCall, Msg: 0, Print: true, Args: noArg, Level: levelName(base)}, true
+		}
+	case "csv":
+		if base, ok := trimmed("Context"); ok {
+			return logFunc{Kind: emitCall, Msg: 1, Args: 2, Level: levelName(base)}, true
+		}
+	case "esv":
+		if name == "Error" {
+			return logFunc{Kind: emitCall, Msg: 1, Args: 2, Level: "error"}, true
+		}
+	}
+	return logFunc{}, false
+}
+
+// isEmptyInterface reports whether t is interface{} (any).
+func isEmptyInterface(t types.Type) bool {
+	iface, ok := t.Underlying().(*types.Interface)
+	return ok && iface.Empty()
+}
+
+// receiverName returns the name of fn's receiver type with any pointer
+// stripped, or "" when fn is a package-level function.
+func receiverName(fn *types.Func) string {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return ""
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+	return ""
+}
@@ -24,7 +24,7 @@ func f() {
 
 	// --- log format methods ---
 	log.Printf("Starting: %s", msg)          // want `log message must start with a lowercase letter`
-	log.Printf("user token: %s", userToken)  // want "log message may expose sensitive data" "log message may expose sensitive data"
+	log.Printf("user token: %s", userToken)  // want `log message may expose sensitive data: argument "userToken" is labelled "token"`
 
 	// --- slog ---
 	slog.Info("server ready")
@@ -0,0 +1,45 @@
+package consts
+
+import (
+	"log"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const startMsg = "Starting server"
+const readyMsg = "server ready"
+const tokenHeader = "token: "
+
+// bannerMsg has several uses, so the fix does not edit it.
+const bannerMsg = "Welcome"
+
+func fConsts() {
+	log.Print(startMsg) // want `log message must start with a lowercase letter`
+	log.Print(readyMsg)
+	log.Print(bannerMsg) // want `log message must start with a lowercase letter`
+	log.Print(bannerMsg) // want `log message must start with a lowercase letter`
+	slog.Info(tokenHeader + readyMsg) // want `literal contains "token"` `variable "tokenHeader"`
+
+	// --- constants of other modules are not the module's own text ---
+	log.Print(time.RFC1123)
+	log.Print(http.MethodGet)
+
+	// --- locals assigned exactly once from a literal; msg is used again
+	// below, so its literal is not edited either ---
+	msg := "Shutting down"
+	log.Print(msg) // want `log message must start with a lowercase letter`
+
+	greeting := "hello 🚀"
+	slog.Info(greeting) // want `log message must not contain emoji`
+
+	// --- reassigned locals are not resolved ---
+	status := "ok"
+	if len(msg) > 3 {
+		status = "Failed"
+	}
+	log.Print(status)
+
+	var apiSecret = "abc"
+	log.Print("value " + apiSecret) // want "log message may expose sensitive data"
+}
@@ -0,0 +1,34 @@
+package applog
+
+// Log wrappers detected through facts by the wrapper tests.
+
+import (
+	"context"
+	"log"
+	"log/slog"
+)
+
+func Infof(ctx context.Context, format string, args ...any) { // want Infof:`logWrapper\(format=1\)`
+	log.Printf(format, args...)
+}
+
+func Event(name, msg string) { // want Event:`logWrapper\(msg=1\)`
+	slog.Info(msg, "event", name)
+}
+
+// Notice forwards its message through another wrapper.
+func Notice(msg string) { // want Notice:`logWrapper\(msg=0\)`
+	Event("notice", msg)
+}
+
+type Logger struct{}
+
+func (l *Logger) Warn(msg string) { // want Warn:`logWrapper\(msg=0\)`
+	slog.Warn(msg)
+}
+
+// Describe does not forward its parameter to a log call.
+func Describe(name string) string {
+	log.Print("describing")
+	return "name: " + name
+}
@@ -0,0 +1,5 @@
+package mask
+
+func Email(e string) string { return "" }
+
+func Last4(s string) string { return "" }
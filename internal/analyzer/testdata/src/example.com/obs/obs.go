@@ -0,0 +1,8 @@
+package obs
+
+// Project-specific structured logger used by the custom loggers tests.
+
+type Logger struct{}
+
+func (l *Logger) Event(name, msg string, fields ...any) {}
+func (l *Logger) Name() string                          { return "obs" }
@@ -0,0 +1,9 @@
+package ourlog
+
+// Project-specific logging wrapper used by the custom loggers tests.
+
+import "context"
+
+func Infof(ctx context.Context, msg string, args ...any)  {}
+func Errorf(ctx context.Context, msg string, args ...any) {}
+func Flush(ctx context.Context, reason string)            {}
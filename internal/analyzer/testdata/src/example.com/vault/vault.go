@@ -0,0 +1,5 @@
+package vault
+
+type Client struct{}
+
+func (c *Client) Secret(path string) string { return "" }
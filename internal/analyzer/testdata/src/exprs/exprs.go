@@ -0,0 +1,39 @@
+package exprs
+
+import (
+	"log"
+	"log/slog"
+)
+
+type config struct {
+	Password string
+	Host     string
+	Nested   struct{ Token string }
+}
+
+type user struct {
+	Name string
+}
+
+func (u *user) GetSecret() string   { return "" }
+func (u *user) DisplayName() string { return u.Name }
+
+func fExprs(cfg config, u *user, headers map[string]string, raw []byte, v any) {
+	// --- selectors: the full path is checked ---
+	log.Print("connecting with " + cfg.Password) // want `variable "cfg.Password" matches keyword "password"`
+	log.Print("connecting to " + cfg.Host)
+	log.Print("using " + cfg.Nested.Token) // want `variable "cfg.Nested.Token" matches keyword "token"`
+
+	// --- method calls: the called method name is checked ---
+	slog.Info("user " + u.GetSecret()) // want `variable "u.GetSecret\(\)" matches keyword "secret"`
+	slog.Info("user " + u.DisplayName())
+
+	// --- index expressions ---
+	log.Print("header " + headers["X-Api-Key"]) // want `variable "headers\[.*X-Api-Key.*\]" matches keyword "key"`
+
+	// --- parentheses and conversions are looked through ---
+	log.Print(("Started")) // want `log message must start with a lowercase letter`
+	log.Print("body " + string(raw))
+	log.Print("token " + (cfg.Host)) // want `literal contains "token"`
+	log.Print("value " + v.(string))
+}
@@ -0,0 +1,30 @@
+package formatted
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"strings"
+)
+
+func fFormatted(name, apiToken string, parts []string, err error) {
+	// --- fmt helpers are transparent ---
+	slog.Info(fmt.Sprintf("User %s logged in", name))          // want `log message must start with a lowercase letter`
+	slog.Info(fmt.Sprintf("user %s token %s", name, apiToken)) // want `argument "apiToken" is labelled "token"`
+	log.Printf("token: %s", (apiToken))                        // want `argument "\(apiToken\)" is labelled "token"`
+	log.Printf("token: %s", "Bearer "+apiToken)                // want `argument "\\"Bearer \\" \+ apiToken" is labelled "token"`
+	log.Print(fmt.Sprint("value ", apiToken))                  // want `variable "apiToken"`
+	log.Print(fmt.Sprintln("запуск", name))                    // want `log message must be in English`
+	slog.Info(fmt.Sprintf("user %s", name))
+
+	// --- errors.New(...).Error() and fmt.Errorf(...).Error() ---
+	log.Print(errors.New("Failed to connect").Error()) // want `log message must start with a lowercase letter`
+	log.Print(fmt.Errorf("bad password for %s", name).Error())
+	log.Print(err.Error())
+
+	// --- strings.Join over literal slices ---
+	log.Print(strings.Join([]string{"Request", name}, " "))   // want `log message must start with a lowercase letter`
+	log.Print(strings.Join([]string{"user", apiToken}, ": ")) // want `variable "apiToken"`
+	log.Print(strings.Join(parts, " "))
+}
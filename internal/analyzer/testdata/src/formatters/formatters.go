@@ -0,0 +1,69 @@
+package formatters
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+type Credentials struct {
+	User     string
+	Password string
+	APIKey   []byte
+	Attempts int
+}
+
+func (c Credentials) String() string {
+	return c.User + ":" + c.Password // want `String method may expose sensitive data: field Password matches keyword "password"`
+}
+
+func (c Credentials) GoString() string {
+	return fmt.Sprintf("Credentials{%q, %x, %d}", c.User, c.APIKey, c.Attempts) // want `GoString method may expose sensitive data: field APIKey matches keyword "apikey"`
+}
+
+func (c *Credentials) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("user", c.User),
+		slog.String("password", c.Password), // want `literal contains "password"` `variable "c.Password"` `LogValue method may expose sensitive data: field Password matches keyword "password"`
+		slog.Int("attempts", c.Attempts),
+	)
+}
+
+type session struct {
+	ID     string
+	Secret []byte
+}
+
+func (s session) String() string {
+	key := s.Secret // want `String method may expose sensitive data: field Secret matches keyword "secret"`
+	return s.ID + string(key[:0])
+}
+
+type safe struct { // want safe:`redacts\(String\)`
+	User     string
+	Password string
+}
+
+func (s safe) String() string {
+	return s.User
+}
+
+type login struct {
+	User  string
+	Token string
+}
+
+func (l login) String() string {
+	if l.Token == "" { // want `String method may expose sensitive data: field Token matches keyword "token"`
+		return l.User
+	}
+	return l.User + " " + l.Token // want `String method may expose sensitive data: field Token matches keyword "token"`
+}
+
+type account struct {
+	Password string
+}
+
+func (a *account) GoString() string {
+	p := &a.Password // want `GoString method may expose sensitive data: field Password matches keyword "password"`
+	return fmt.Sprintf("account{%s}", *p)
+}
@@ -0,0 +1,10 @@
+package level
+
+// Stub implementation of github.com/go-kit/log/level for analysistest.
+
+import "github.com/go-kit/log"
+
+func Debug(logger log.Logger) log.Logger { return logger }
+func Info(logger log.Logger) log.Logger  { return logger }
+func Warn(logger log.Logger) log.Logger  { return logger }
+func Error(logger log.Logger) log.Logger { return logger }
@@ -0,0 +1,11 @@
+package log
+
+// Stub implementation of github.com/go-kit/log for analysistest.
+
+type Logger interface {
+	Log(keyvals ...interface{}) error
+}
+
+func NewNopLogger() Logger { return nil }
+
+func With(logger Logger, keyvals ...interface{}) Logger { return logger }
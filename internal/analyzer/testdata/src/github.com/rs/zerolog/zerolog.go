@@ -0,0 +1,44 @@
+package zerolog
+
+// Stub implementation of github.com/rs/zerolog for analysistest.
+
+type Level int8
+
+const (
+	DebugLevel Level = iota
+	InfoLevel
+	WarnLevel
+	ErrorLevel
+)
+
+type Logger struct{}
+
+func New() Logger { return Logger{} }
+
+func (l Logger) Info() *Event                           { return &Event{} }
+func (l Logger) Error() *Event                          { return &Event{} }
+func (l Logger) Err(err error) *Event                   { return &Event{} }
+func (l Logger) WithLevel(level Level) *Event           { return &Event{} }
+func (l Logger) Log() *Event                            { return &Event{} }
+func (l Logger) With() Context                          { return Context{} }
+func (l Logger) Print(v ...interface{})                 {}
+func (l Logger) Printf(format string, v ...interface{}) {}
+
+type Event struct{}
+
+func Dict() *Event { return &Event{} }
+
+func (e *Event) Msg(msg string)                             {}
+func (e *Event) Msgf(format string, v ...interface{})       {}
+func (e *Event) Send()                                      {}
+func (e *Event) Str(key, val string) *Event                 { return e }
+func (e *Event) Int(key string, i int) *Event               { return e }
+func (e *Event) Interface(key string, i interface{}) *Event { return e }
+func (e *Event) Any(key string, i interface{}) *Event       { return e }
+func (e *Event) Dict(key string, dict *Event) *Event        { return e }
+func (e *Event) Err(err error) *Event                       { return e }
+
+type Context struct{}
+
+func (c Context) Str(key, val string) Context { return c }
+func (c Context) Logger() Logger              { return Logger{} }
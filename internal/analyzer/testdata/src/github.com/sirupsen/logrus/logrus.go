@@ -0,0 +1,44 @@
+package logrus
+
+// Stub implementation of github.com/sirupsen/logrus for analysistest.
+
+type Fields map[string]interface{}
+
+type Level uint32
+
+const InfoLevel Level = 4
+
+type Logger struct{}
+
+func New() *Logger { return &Logger{} }
+
+func (l *Logger) Info(args ...interface{})                       {}
+func (l *Logger) Infof(format string, args ...interface{})       {}
+func (l *Logger) Infoln(args ...interface{})                     {}
+func (l *Logger) Error(args ...interface{})                      {}
+func (l *Logger) Errorf(format string, args ...interface{})      {}
+func (l *Logger) Log(level Level, args ...interface{})           {}
+func (l *Logger) WithField(key string, value interface{}) *Entry { return &Entry{} }
+func (l *Logger) WithFields(fields Fields) *Entry                { return &Entry{} }
+func (l *Logger) WithError(err error) *Entry                     { return &Entry{} }
+
+type Entry struct{}
+
+func (e *Entry) Info(args ...interface{})                       {}
+func (e *Entry) Infof(format string, args ...interface{})       {}
+func (e *Entry) Infoln(args ...interface{})                     {}
+func (e *Entry) Warn(args ...interface{})                       {}
+func (e *Entry) Error(args ...interface{})                      {}
+func (e *Entry) WithField(key string, value interface{}) *Entry { return e }
+func (e *Entry) WithFields(fields Fields) *Entry                { return e }
+func (e *Entry) WithError(err error) *Entry                     { return e }
+
+func Info(args ...interface{})                       {}
+func Infof(format string, args ...interface{})       {}
+func Infoln(args ...interface{})                     {}
+func Warn(args ...interface{})                       {}
+func Error(args ...interface{})                      {}
+func Errorf(format string, args ...interface{})      {}
+func WithField(key string, value interface{}) *Entry { return &Entry{} }
+func WithFields(fields Fields) *Entry                { return &Entry{} }
+func WithError(err error) *Entry                     { return &Entry{} }
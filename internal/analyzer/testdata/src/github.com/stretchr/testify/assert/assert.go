@@ -0,0 +1,9 @@
+package assert
+
+// Stub implementation of github.com/stretchr/testify/assert for analysistest.
+
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+func Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool { return true }
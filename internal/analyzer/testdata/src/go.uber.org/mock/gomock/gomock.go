@@ -0,0 +1,8 @@
+package gomock
+
+// Stub implementation of go.uber.org/mock/gomock for analysistest.
+
+type TestReporter interface {
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+}
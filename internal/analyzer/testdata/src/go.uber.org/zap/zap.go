@@ -2,49 +2,46 @@ package zap
 
 // Stub-реализация go.uber.org/zap для тестов analysistest.
 
+import "go.uber.org/zap/zapcore"
+
 type Logger struct{}
 
 func NewNop() *Logger { return &Logger{} }
 
-func (l *Logger) Info(msg string, fields ...Field)  {}
-func (l *Logger) Warn(msg string, fields ...Field)  {}
-func (l *Logger) Error(msg string, fields ...Field) {}
-func (l *Logger) Debug(msg string, fields ...Field) {}
-func (l *Logger) Sugar() *SugaredLogger            { return &SugaredLogger{} }
-func (l *Logger) With(fields ...Field) *Logger     { return l }
-
-type SugaredLogger struct{}
-
-func (s *SugaredLogger) Infof(template string, args ...interface{})  {}
-func (s *SugaredLogger) Warnf(template string, args ...interface{})  {}
-func (s *SugaredLogger) Errorf(template string, args ...interface{}) {}
-func (s *SugaredLogger) Debugf(template string, args ...interface{}) {}
-
-type Field struct{}
-
-func String(key, val string) Field { return Field{} }
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
+func (l *Logger) Info(msg string, fields ...Field)           {}
+func (l *Logger) Warn(msg string, fields ...Field)           {}
+func (l *Logger) Error(msg string, fields ...Field)          {}
+func (l *Logger) Debug(msg string, fields ...Field)          {}
+func (l *Logger) Sugar() *SugaredLogger                      { return &SugaredLogger{} }
+func (l *Logger) With(fields ...Field) *Logger               { return l }
+func (l *Logger) Log(lvl Level, msg string, fields ...Field) {}
 
+type Level int8
 
+const InfoLevel Level = 0
 
+type SugaredLogger struct{}
 
+func (s *SugaredLogger) Infof(template string, args ...interface{})      {}
+func (s *SugaredLogger) Warnf(template string, args ...interface{})      {}
+func (s *SugaredLogger) Errorf(template string, args ...interface{})     {}
+func (s *SugaredLogger) Debugf(template string, args ...interface{})     {}
+func (s *SugaredLogger) Info(args ...interface{})                        {}
+func (s *SugaredLogger) Infoln(args ...interface{})                      {}
+func (s *SugaredLogger) Infow(msg string, keysAndValues ...interface{})  {}
+func (s *SugaredLogger) Errorw(msg string, keysAndValues ...interface{}) {}
+func (s *SugaredLogger) With(args ...interface{}) *SugaredLogger         { return s }
+
+// Field, как и в настоящем zap, — псевдоним zapcore.Field.
+type Field = zapcore.Field
+
+func String(key, val string) Field                                { return Field{} }
+func Int(key string, val int) Field                               { return Field{} }
+func Any(key string, val interface{}) Field                       { return Field{} }
+func Stringer(key string, val interface{ String() string }) Field { return Field{} }
+func Object(key string, val interface{}) Field                    { return Field{} }
+func Reflect(key string, val interface{}) Field                   { return Field{} }
+func Namespace(key string) Field                                  { return Field{} }
+func Inline(val interface{}) Field                                { return Field{} }
+func Dict(key string, val ...Field) Field                         { return Field{} }
+func Error(err error) Field                                       { return Field{} }
@@ -0,0 +1,17 @@
+package zapcore
+
+// Stub-реализация go.uber.org/zap/zapcore для тестов analysistest.
+
+type Field struct {
+	Key    string
+	String string
+}
+
+type ObjectEncoder interface {
+	AddString(key, value string)
+	AddReflected(key string, value interface{}) error
+}
+
+type ObjectMarshaler interface {
+	MarshalLogObject(ObjectEncoder) error
+}
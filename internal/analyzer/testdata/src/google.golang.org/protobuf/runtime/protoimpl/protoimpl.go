@@ -0,0 +1,15 @@
+package protoimpl
+
+// Stub-реализация google.golang.org/protobuf/runtime/protoimpl для тестов analysistest.
+
+type MessageState struct{}
+
+type SizeCache = int32
+
+type UnknownFields = []byte
+
+type export struct{}
+
+func (export) MessageStringOf(m interface{}) string { return "" }
+
+var X export
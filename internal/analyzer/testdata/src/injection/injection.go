@@ -0,0 +1,61 @@
+package injection
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func fRequest(w http.ResponseWriter, r *http.Request) {
+	// --- request parameters, headers and fields in the message text ---
+	log.Printf("user %s logged in", r.FormValue("user")) // want `untrusted input: "r.FormValue\(\\"user\\"\)" holds the request parameter "user"`
+	name := r.URL.Query().Get("name")
+	log.Printf("hello %v", name)          // want `untrusted input: "name" holds the request parameter "name"`
+	log.Printf("path %-20s", r.URL.Path)  // want `untrusted input: "r.URL.Path" holds the request URL path`
+	log.Println("agent " + r.UserAgent()) // want `untrusted input: "r.UserAgent\(\)" holds the User-Agent header`
+	lang := strings.ToLower(r.Header.Get("Accept-Language"))
+	log.Print("language ", lang)      // want `untrusted input: "lang" holds the Accept-Language header`
+	log.Printf("host %s", r.Host)     // want `untrusted input: "r.Host" holds the request host`
+	log.Printf("query %s", search(r)) // want `untrusted input: "search\(r\)" holds the request parameter "q"`
+
+	// --- quoted, escaped or non-text values ---
+	log.Printf("user %q logged in", r.FormValue("user"))
+	log.Printf("hello %s", strconv.Quote(name))
+	log.Printf("hello %s", url.QueryEscape(name))
+	log.Printf("hello %s", strings.ReplaceAll(name, "\n", " "))
+	log.Printf("name has %d bytes", len(name))
+
+	// --- structured attributes are not message text ---
+	slog.Info("user logged in", "user", r.FormValue("user"))
+	slog.Info(fmt.Sprintf("hello %s", name)) // want `untrusted input: "name" holds the request parameter "name"`
+}
+
+func search(r *http.Request) string { // want search:`input\(0, the request parameter "q"\)`
+	return r.URL.Query().Get("q")
+}
+
+func fScanner() {
+	// --- lines read from standard input ---
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := scanner.Text()
+		log.Printf("read %s", line) // want `untrusted input: "line" holds input read with bufio.Scanner`
+		log.Printf("read %q", line)
+	}
+	reader := bufio.NewReader(os.Stdin)
+	answer, _ := reader.ReadString('\n')
+	log.Printf("answer was %s", answer) // want `untrusted input: "answer" holds input read with bufio.Reader`
+}
+
+func fConstFormat(r *http.Request) {
+	// --- no fix when the format string is not written in the call ---
+	const format = "page %s"
+	log.Printf(format, r.FormValue("page"))    // want `untrusted input: "r.FormValue\(\\"page\\"\)"`
+	log.Printf("tab\t%s", r.FormValue("page")) // want `untrusted input: "r.FormValue\(\\"page\\"\)"`
+}
@@ -0,0 +1,22 @@
+package klog
+
+// Stub implementation of k8s.io/klog/v2 for analysistest.
+
+type Level int32
+
+type Verbose struct{}
+
+func V(level Level) Verbose { return Verbose{} }
+
+func (v Verbose) Enabled() bool                                      { return false }
+func (v Verbose) Info(args ...any)                                   {}
+func (v Verbose) Infof(format string, args ...any)                   {}
+func (v Verbose) InfoS(msg string, keysAndValues ...any)             {}
+func (v Verbose) ErrorS(err error, msg string, keysAndValues ...any) {}
+
+func Info(args ...any)                                   {}
+func Infof(format string, args ...any)                   {}
+func InfoDepth(depth int, args ...any)                   {}
+func Errorf(format string, args ...any)                  {}
+func InfoS(msg string, keysAndValues ...any)             {}
+func ErrorS(err error, msg string, keysAndValues ...any) {}
@@ -0,0 +1,42 @@
+package pii
+
+import (
+	"crypto/sha256"
+	"log"
+	"log/slog"
+)
+
+type customer struct {
+	Name  string
+	Email string
+	DOB   string
+}
+
+func fLiterals() {
+	// --- personal data written into messages ---
+	log.Println("welcome jane.doe@example.com")          // want `personal data: literal contains an email address`
+	log.Println("calling +1 (415) 555-2671")             // want `personal data: literal contains a phone number`
+	log.Println("request from 203.0.113.7")              // want `personal data: literal contains an IP address`
+	log.Println("request from 2001:db8::8a2e:370:7334")  // want `personal data: literal contains an IP address`
+	log.Println("charging 4111 1111 1111 1111")          // want `personal data: literal contains a card number`
+	log.Println("paying to GB82 WEST 1234 5698 7654 32") // want `personal data: literal contains an IBAN`
+	log.Println("checking document AB1234567")           // want `personal data: literal contains a passport-like document number`
+	slog.Info("login", "client", "198.51.100.23")        // want `personal data: literal contains an IP address`
+	log.Println("charging 4111 1111 1111 1112")
+
+	// --- addresses and numbers that identify no one ---
+	log.Println("listening on 127.0.0.1:8080")
+	log.Println("listening on [::1]:8080")
+	log.Println("started at 12:30:45 on 2024-01-31")
+}
+
+func fIdentifiers(c customer, ssn, phoneNumber string) {
+	// --- values named after personal data ---
+	log.Printf("customer %s", c.Email)      // want `personal data: variable "c.Email" matches keyword "email"`
+	log.Println("born", c.DOB)              // want `personal data: variable "c.DOB" matches keyword "dob"`
+	slog.Info("lookup", "ssn", ssn)         // want `personal data: variable "ssn" matches keyword "ssn"`
+	log.Printf("sms to %s", phoneNumber)    // want `personal data: variable "phoneNumber" matches keyword "phone"`
+	log.Printf("contact email: %s", c.Name) // want `personal data: argument "c.Name" is labelled "email"`
+	log.Printf("hash %x", sha256.Sum256([]byte(ssn)))
+	log.Printf("customer %s", c.Name)
+}
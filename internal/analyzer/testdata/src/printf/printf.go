@@ -0,0 +1,27 @@
+package printf
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+)
+
+func fPrintf(t, name string, n int, user struct{ ID int }) {
+	// --- a keyword labelling a verb names the formatted argument ---
+	log.Printf("token: %s", t)                 // want `argument "t" is labelled "token"`
+	log.Printf("user %s password=%q", name, t) // want `argument "t" is labelled "password"`
+	log.Printf("id %d, secret %v", user.ID, t) // want `argument "t" is labelled "secret"`
+	log.Printf("%[2]s key %[1]s", t, name)     // want `argument "t" is labelled "key"`
+	log.Printf("auth: %*d", n, n)              // want `argument "n" is labelled "auth"`
+
+	// --- keywords in prose are low confidence and not reported by default ---
+	log.Printf("token expired after %d retries", n)
+	log.Printf("password reset requested by %s", name)
+	slog.Info(fmt.Sprintf("refreshing token for %s", name))
+
+	// --- without verbs the literal is checked as before ---
+	log.Printf("token expired") // want `literal contains "token"`
+
+	// --- escaped percent signs are not verbs ---
+	log.Printf("token 100%% valid for %s", name)
+}
@@ -0,0 +1,38 @@
+package redactfix
+
+import (
+	"log/slog"
+	"sync"
+)
+
+type User struct {
+	Name     string
+	Password string
+	Age      int
+}
+
+// Session holds a lock, so its method takes a pointer receiver.
+type Session struct {
+	mu    sync.Mutex
+	_     [0]func()
+	Token string
+}
+
+type Account struct { // want Account:`redacts\(LogValue\)`
+	ID    int
+	Owner User
+}
+
+func (a Account) LogValue() slog.Value {
+	return slog.GroupValue(slog.Int("id", a.ID))
+}
+
+func fRedact(u User, a Account) {
+	slog.Info("login", "user", u) // want `"u" contains sensitive field Password`
+	slog.Info("account", "account", a)
+	slog.Debug("login again", "user", u) // want `"u" contains sensitive field Password`
+}
+
+func fSession(s *Session) {
+	slog.Info("session", "session", s) // want `"s" contains sensitive field Token`
+}
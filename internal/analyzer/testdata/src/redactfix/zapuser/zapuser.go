@@ -0,0 +1,12 @@
+package zapuser
+
+import "go.uber.org/zap"
+
+type Client struct {
+	ID     string
+	APIKey string
+}
+
+func fRedact(logger *zap.Logger, c Client) {
+	logger.Info("client", zap.Any("client", c)) // want `"c" contains sensitive field APIKey`
+}
@@ -0,0 +1,56 @@
+package sensitivetypes
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"database/sql"
+	"io"
+	"log"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+type incoming struct {
+	ID  int
+	Req *http.Request
+}
+
+type outer struct {
+	Inner incoming
+}
+
+type described struct {
+	Conf *tls.Config
+}
+
+func (described) String() string { return "described" }
+
+func fTypes(r *http.Request, pk *rsa.PrivateKey, conf *tls.Config, dsn string, u *url.URL) {
+	// --- catalogue types, directly ---
+	log.Printf("%+v", r)                      // want `"r" has sensitive type net/http.Request`
+	slog.Info("request", "headers", r.Header) // want `"r.Header" has sensitive type net/http.Header`
+	slog.Info("loaded", slog.Any("pk", pk))   // want `"pk" has sensitive type crypto/rsa.PrivateKey`
+	log.Print("tls ", conf)                   // want `"conf" has sensitive type crypto/tls.Config`
+	log.Print("user ", u.User)                // want `"u.User" has sensitive type net/url.Userinfo`
+	log.Print("url ", u)
+
+	// --- nested in logged structs ---
+	in := incoming{ID: 1, Req: r}
+	log.Printf("%v", in)                        // want `"in" contains net/http.Request in field Req`
+	slog.Info("wrapped", "v", outer{Inner: in}) // want `contains net/http.Request in field Inner.Req`
+
+	// --- types that format themselves are not visited ---
+	log.Print("d ", described{Conf: conf})
+
+	// --- values whose origin is sensitive ---
+	body, _ := io.ReadAll(r.Body)
+	log.Printf("body: %s", string(body)) // want `"body" holds an HTTP body read with io.ReadAll`
+
+	db, _ := sql.Open("postgres", dsn)
+	_ = db
+	log.Print("connecting to ", dsn) // want `"dsn" holds a database/sql DSN`
+
+	endpoint := &url.URL{Scheme: "https", Host: "db", User: url.UserPassword("admin", "x")}
+	log.Print("endpoint ", endpoint) // want `"endpoint" holds a URL with userinfo`
+}
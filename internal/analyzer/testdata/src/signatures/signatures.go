@@ -0,0 +1,48 @@
+package signatures
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+var sigCtx = context.Background()
+var sigComponent = "api"
+var sigName = "k"
+var sigUserToken = "tok"
+
+func fSignatures() {
+	// --- slog attribute constructors: keys are not messages ---
+	_ = slog.String("Name", sigComponent)
+	_ = slog.With("Component", sigComponent)
+	_ = slog.String("password", sigComponent) // want "log message may expose sensitive data"
+
+	// --- slog: message is not always the first argument ---
+	slog.InfoContext(sigCtx, "request served")
+	slog.InfoContext(sigCtx, "Request served")              // want `log message must start with a lowercase letter`
+	slog.Log(sigCtx, slog.LevelInfo, "Request served")      // want `log message must start with a lowercase letter`
+	slog.LogAttrs(sigCtx, slog.LevelInfo, "Request served") // want `log message must start with a lowercase letter`
+	slog.Default().ErrorContext(sigCtx, "failed!!")         // want `log message must not contain repeated punctuation`
+	slog.Default().WithGroup("Auth")
+
+	// --- std log ---
+	log.Output(2, "Starting server")              // want `log message must start with a lowercase letter`
+	log.Println("server", "Started 🚀")            // want `log message must not contain emoji`
+	log.Default().Println("user token:", sigName) // want "log message may expose sensitive data"
+	log.SetPrefix("API: ")
+
+	// --- zap ---
+	logger := zap.NewNop()
+	_ = zap.String("Name", sigName)
+	_ = zap.String("token", sigName) // want "log message may expose sensitive data"
+	logger.With(zap.String("Component", sigComponent)).Info("ready")
+	logger.Log(zap.InfoLevel, "Ready") // want `log message must start with a lowercase letter`
+
+	sugar := logger.Sugar()
+	sugar.Info("Ready") // want `log message must start with a lowercase letter`
+	sugar.Infoln("ready", "now")
+	sugar.Infow("Ready", "component", sigComponent) // want `log message must start with a lowercase letter`
+	sugar.Infow("user " + sigUserToken)             // want "log message may expose sensitive data"
+}
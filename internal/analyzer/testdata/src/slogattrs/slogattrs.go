@@ -0,0 +1,39 @@
+package slogattrs
+
+import (
+	"context"
+	"log/slog"
+)
+
+var saCtx = context.Background()
+var pw = "hunter2"
+var saUser = "alice"
+var saAPIKey = "k"
+var saUserAttr = slog.String("user", "alice")
+var saArgs = []any{"user", "alice"}
+
+func fSlogAttrs() {
+	// --- clean key/value pairs ---
+	slog.Info("login", "user", saUser, "attempt", 1)
+	slog.Info("login", slog.String("user", saUser), slog.Int("attempt", 1))
+
+	// --- sensitive keys and values in key/value pairs ---
+	slog.Info("login", "password", pw)                // want "log message may expose sensitive data"
+	slog.Info("login", "user", saUser, "k", saAPIKey) // want "log message may expose sensitive data"
+	slog.Warn("login", "user", saUser, "token")       // want "log message may expose sensitive data"
+
+	// --- slog.Attr constructors, nested groups ---
+	slog.Info("x", slog.String("api_key", saUser))                      // want "log message may expose sensitive data"
+	slog.Info("x", slog.Group("auth", "user", saUser))                  // want "log message may expose sensitive data"
+	slog.Info("x", slog.Group("req", slog.Group("session", "jwt", pw))) // want "log message may expose sensitive data"
+	slog.Info("x", slog.Group("req", slog.Any("credential", saUser)))   // want "log message may expose sensitive data"
+
+	// --- LogAttrs, Logger methods, Context variants ---
+	slog.LogAttrs(saCtx, slog.LevelInfo, "x", slog.String("secret", saUser)) // want "log message may expose sensitive data"
+	slog.Default().InfoContext(saCtx, "x", "passwd", saUser)                 // want "log message may expose sensitive data"
+	slog.Default().With("token", saUser).Info("x")                           // want "log message may expose sensitive data"
+
+	// --- attributes and argument slices passed by name ---
+	slog.Info("x", saUserAttr)
+	slog.Info("x", saArgs...)
+}
@@ -0,0 +1,22 @@
+package secrets
+
+import (
+	"os"
+	"strings"
+)
+
+// DatabasePassword reads the database password from the environment.
+func DatabasePassword() string { // want DatabasePassword:`taints\(0, the environment variable DB_PASSWORD\)`
+	return strings.TrimSpace(os.Getenv("DB_PASSWORD"))
+}
+
+// Lookup returns a secret and whether it is set.
+func Lookup() (bool, string) { // want Lookup:`taints\(1, the environment variable API_TOKEN\)`
+	v, ok := os.LookupEnv("API_TOKEN")
+	return ok, v
+}
+
+// Region is not sensitive.
+func Region() string {
+	return os.Getenv("AWS_REGION")
+}
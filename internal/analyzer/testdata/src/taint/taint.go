@@ -0,0 +1,97 @@
+package taint
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	"taint/secrets"
+)
+
+func fEnv() {
+	// --- environment variables named after a keyword ---
+	v := os.Getenv("DB_PASSWORD")
+	log.Printf("connecting with %s", v) // want `"v" holds the environment variable DB_PASSWORD`
+
+	conn := "postgres://app:" + v + "@db/app"
+	slog.Info("connecting", "conn", conn) // want `"conn" holds the environment variable DB_PASSWORD`
+
+	upper := strings.ToUpper(strings.TrimSpace(v))
+	msg := fmt.Sprintf("using %s", upper)
+	log.Print(msg) // want `"msg" holds the environment variable DB_PASSWORD`
+
+	home := os.Getenv("HOME")
+	log.Printf("home is %s", home)
+	log.Printf("password length is %d", len(v))
+}
+
+func fReassigned(verbose bool) {
+	// --- a later safe assignment replaces the sensitive value ---
+	v := os.Getenv("DB_PASSWORD")
+	v = fmt.Sprint(len(v))
+	log.Printf("password length %s", v)
+
+	// --- but not one made on a single branch, or after the call ---
+	w := os.Getenv("DB_PASSWORD")
+	if verbose {
+		w = "hidden"
+	}
+	log.Printf("using %s", w) // want `"w" holds the environment variable DB_PASSWORD`
+
+	x := os.Getenv("DB_PASSWORD")
+	log.Printf("using %s", x) // want `"x" holds the environment variable DB_PASSWORD`
+	x = ""
+	log.Printf("cleared %s", x)
+}
+
+func fRequest(w http.ResponseWriter, r *http.Request) {
+	// --- credential headers ---
+	h := r.Header.Get("Authorization")
+	slog.Info("req", "h", h) // want `"h" holds the Authorization header`
+
+	bearer := strings.TrimPrefix(h, "Bearer ")
+	log.Println("bearer", bearer) // want `"bearer" holds the Authorization header`
+
+	for _, c := range r.Header["Cookie"] {
+		log.Println("cookie", c) // want `"c" holds the Cookie header`
+	}
+	slog.Info("agent", "ua", r.Header.Get("User-Agent"))
+
+	// --- request parameters named after a keyword ---
+	p := r.FormValue("password")
+	log.Printf("form %s", p) // want `"p" holds the request parameter "password"`
+	q := r.URL.Query().Get("access_token")
+	log.Printf("query %s", q)                  // want `"q" holds the request parameter "access_token"`
+	log.Printf("page %s", r.FormValue("page")) // want `untrusted input: "r.FormValue\(\\"page\\"\)"`
+
+	// --- URL userinfo and basic auth ---
+	pw, _ := r.URL.User.Password()
+	log.Printf("userinfo %s", pw) // want `"pw" holds the userinfo of a URL`
+	user, pass, _ := r.BasicAuth()
+	log.Printf("basic auth for %s", user) // want `untrusted input: "user" holds the HTTP basic auth username`
+	slog.Info("basic", "b", pass)         // want `"pass" matches keyword "pass"` `"pass" holds the HTTP basic auth password`
+
+	// --- cookie values ---
+	if session, err := r.Cookie("session"); err == nil {
+		log.Printf("session %s", session.Value) // want `"session.Value" holds a cookie value`
+	}
+}
+
+func fInterprocedural() {
+	// --- function results, within the package and through facts ---
+	log.Printf("db %s", secrets.DatabasePassword()) // want `"secrets.DatabasePassword\(\)" matches keyword "password"` `"secrets.DatabasePassword\(\)" holds the environment variable DB_PASSWORD`
+	_, t := secrets.Lookup()
+	log.Printf("token %s", t) // want `argument "t" is labelled "token"` `"t" holds the environment variable API_TOKEN`
+	log.Printf("region %s", secrets.Region())
+
+	k := apiKey()
+	log.Printf("k=%s", k) // want `"k" holds the environment variable SERVICE_API_KEY`
+}
+
+func apiKey() string { // want apiKey:`taints\(0, the environment variable SERVICE_API_KEY\)`
+	key := os.Getenv("SERVICE_API_KEY")
+	return key
+}
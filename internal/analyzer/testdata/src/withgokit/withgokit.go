@@ -0,0 +1,25 @@
+package withgokit
+
+import (
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+var gkUser = "alice"
+
+func fGoKit() {
+	logger := log.NewNopLogger()
+
+	// --- the message is the value of the "msg" key ---
+	logger.Log("msg", "service started", "addr", ":8080")
+	logger.Log("msg", "Service started")                       // want `log message must start with a lowercase letter`
+	logger.Log("addr", ":8080", "msg", "сервис запущен")       // want `log message must be in English`
+	level.Info(logger).Log("msg", "Listening")                 // want `log message must start with a lowercase letter`
+	level.Error(logger).Log("msg", "failed!!!", "err", "boom") // want `log message must not contain repeated punctuation`
+
+	// --- other pairs only get the sensitive-data checks ---
+	logger.Log("event", "Started")
+	logger.Log("msg", "login", "password", gkUser)          // want "log message may expose sensitive data"
+	log.With(logger, "api_key", gkUser).Log("msg", "ready") // want "log message may expose sensitive data"
+	logger.Log("msg", "login", "user")                      // want "log call has an odd number of key/value arguments"
+}
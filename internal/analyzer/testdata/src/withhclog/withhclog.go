@@ -0,0 +1,19 @@
+package withhclog
+
+import "github.com/hashicorp/go-hclog"
+
+var hcUser = "alice"
+
+func fHclog() {
+	logger := hclog.Default()
+
+	logger.Info("plugin started", "name", hcUser)
+	logger.Info("Plugin started")            // want `log message must start with a lowercase letter`
+	logger.Warn("plugin restarted 🔁")        // want `log message must not contain emoji`
+	logger.Log(hclog.Info, "Plugin started") // want `log message must start with a lowercase letter`
+	logger.Named("rpc").Error("Call failed") // want `log message must start with a lowercase letter`
+
+	logger.Info("handshake", "token", hcUser)        // want "log message may expose sensitive data"
+	logger.With("secret", hcUser).Debug("handshake") // want "log message may expose sensitive data"
+	logger.Debug("handshake", "name")                // want "log call has an odd number of key/value arguments"
+}
@@ -0,0 +1,55 @@
+package withifaces
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// Logger is a project-local interface with slog's shape.
+type Logger interface {
+	Info(msg string, kv ...any)
+	InfoContext(ctx context.Context, msg string, args ...any)
+	Error(err error, msg string, kv ...any)
+	Debugf(format string, args ...any)
+	Warn(args ...any)
+	Close() error
+}
+
+// Formatter has a level-like name but is not a logger.
+type Formatter interface {
+	Info(n int) string
+	Error() string
+}
+
+type service struct {
+	log Logger
+	fmt Formatter
+}
+
+var wiCtx = context.Background()
+var wiUser = "alice"
+var wiErr = errors.New("boom")
+
+func (s *service) run() {
+	s.log.Info("request served", "user", wiUser)
+	s.log.Info("Request served")               // want `log message must start with a lowercase letter`
+	s.log.InfoContext(wiCtx, "Request served") // want `log message must start with a lowercase letter`
+	s.log.Error(wiErr, "Request failed")       // want `log message must start with a lowercase letter`
+	s.log.Debugf("Cache size %d", 3)           // want `log message must start with a lowercase letter`
+	s.log.Warn("disk almost full!!!")          // want `log message must not contain repeated punctuation`
+	s.log.Info("login", "password", wiUser)    // want "log message may expose sensitive data"
+	_ = s.log.Close()
+
+	_ = s.fmt.Info(1)
+	_ = s.fmt.Error()
+}
+
+// Test reporters have logger-shaped methods but are not loggers.
+func check(t assert.TestingT, r gomock.TestReporter) {
+	t.Errorf("Expected %s, got %s", wiUser, "bob")
+	r.Fatalf("Unexpected call to %s!!", "Get")
+	r.Errorf("token: %s", wiUser)
+}
@@ -0,0 +1,33 @@
+package withklog
+
+import (
+	"errors"
+
+	"k8s.io/klog/v2"
+)
+
+var klUser = "alice"
+var klErr = errors.New("boom")
+
+func fKlog() {
+	// --- printf-style and print-style ---
+	klog.Info("starting controller")
+	klog.Info("Starting controller")             // want `log message must start with a lowercase letter`
+	klog.Infof("Syncing %s", klUser)             // want `log message must start with a lowercase letter`
+	klog.InfoDepth(1, "Starting controller")     // want `log message must start with a lowercase letter`
+	klog.Errorf("sync failed: token %s", klUser) // want "log message may expose sensitive data"
+
+	// --- structured InfoS / ErrorS ---
+	klog.InfoS("pod synced", "pod", klUser)
+	klog.InfoS("Pod synced", "pod", klUser)                 // want `log message must start with a lowercase letter`
+	klog.ErrorS(klErr, "Sync failed")                       // want `log message must start with a lowercase letter`
+	klog.ErrorS(klErr, "sync failed", "credential", klUser) // want "log message may expose sensitive data"
+	klog.InfoS("pod synced", "pod")                         // want "log call has an odd number of key/value arguments"
+
+	// --- V(n) verbosity ---
+	if klog.V(2).Enabled() {
+		klog.V(2).Info("Verbose details") // want `log message must start with a lowercase letter`
+	}
+	klog.V(4).Infof("Cache size %d", 3)                // want `log message must start with a lowercase letter`
+	klog.V(4).InfoS("cache refreshed", "auth", klUser) // want "log message may expose sensitive data"
+}
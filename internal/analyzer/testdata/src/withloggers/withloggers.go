@@ -0,0 +1,37 @@
+package withloggers
+
+import (
+	"context"
+
+	"example.com/obs"
+	"example.com/ourlog"
+)
+
+var wlCtx = context.Background()
+var wlUser = "alice"
+var wlToken = "tok"
+
+func fLoggers() {
+	// --- package-level printf wrappers ---
+	ourlog.Infof(wlCtx, "user %s logged in", wlUser)
+	ourlog.Infof(wlCtx, "User %s logged in", wlUser) // want `log message must start with a lowercase letter`
+	ourlog.Errorf(wlCtx, "bad token %s", wlToken)    // want `log message may expose sensitive data: argument "wlToken" is labelled "token"`
+	ourlog.Flush(wlCtx, "Shutdown")
+
+	// --- methods with fields ---
+	var l obs.Logger
+	l.Event("Login", "user logged in", "user", wlUser)
+	l.Event("login", "User logged in")                   // want `log message must start with a lowercase letter`
+	l.Event("login", "user logged in", "secret", wlUser) // want "log message may expose sensitive data"
+	_ = l.Name()
+}
+
+// Sink is a project-local interface declared as a logger in the config.
+type Sink interface {
+	Emit(msg string, code int)
+}
+
+func fSink(s Sink) {
+	s.Emit("flushed", 0)
+	s.Emit("Flushed", 0) // want `log message must start with a lowercase letter`
+}
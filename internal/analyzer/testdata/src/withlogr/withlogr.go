@@ -0,0 +1,30 @@
+package withlogr
+
+import (
+	"errors"
+
+	"github.com/go-logr/logr"
+)
+
+var lgUser = "alice"
+var lgErr = errors.New("boom")
+
+func fLogr() {
+	log := logr.Discard()
+
+	// --- message position: first for Info, second for Error ---
+	log.Info("reconciling", "object", lgUser)
+	log.Info("Reconciling", "object", lgUser) // want `log message must start with a lowercase letter`
+	log.Error(lgErr, "reconcile failed", "object", lgUser)
+	log.Error(lgErr, "Reconcile failed") // want `log message must start with a lowercase letter`
+	log.Error(nil, "reconcile failed!!") // want `log message must not contain repeated punctuation`
+
+	// --- V(n) verbosity chains ---
+	log.V(1).Info("Cache refreshed")                               // want `log message must start with a lowercase letter`
+	log.V(2).WithName("cache").Info("refreshed", "secret", lgUser) // want "log message may expose sensitive data"
+
+	// --- key/value pairs ---
+	log.Info("login", "password", lgUser)                     // want "log message may expose sensitive data"
+	log.Error(lgErr, "login failed", "user", lgUser, "token") // want "log message may expose sensitive data" "log call has an odd number of key/value arguments"
+	log.WithValues("api_key", lgUser).Info("ready")           // want "log message may expose sensitive data"
+}
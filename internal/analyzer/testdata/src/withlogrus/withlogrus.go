@@ -0,0 +1,43 @@
+package withlogrus
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+)
+
+var lrLogger = logrus.New()
+var lrUser = "alice"
+var lrPassword = "hunter2"
+var lrErr = errors.New("boom")
+var lrFields = logrus.Fields{"user": "alice"}
+
+func fLogrus() {
+	// --- package-level functions ---
+	logrus.Info("server started")
+	logrus.Info("Server started")           // want `log message must start with a lowercase letter`
+	logrus.Infof("Listening on %s", lrUser) // want `log message must start with a lowercase letter`
+	logrus.Infoln("запуск сервера")         // want `log message must be in English`
+	logrus.Warn("disk almost full!!!")      // want `log message must not contain repeated punctuation`
+	logrus.Errorf("bad pass: %s", lrUser)   // want "log message may expose sensitive data"
+
+	// --- *Logger and *Entry methods ---
+	lrLogger.Info("Ready")                               // want `log message must start with a lowercase letter`
+	lrLogger.Log(logrus.InfoLevel, "Ready")              // want `log message must start with a lowercase letter`
+	lrLogger.WithField("user", lrUser).Info("Logged in") // want `log message must start with a lowercase letter`
+	lrLogger.WithError(lrErr).WithField("Component", lrUser).Error("failed")
+
+	// --- WithField key/value ---
+	logrus.WithField("password", lrUser).Info("login")                          // want "log message may expose sensitive data"
+	logrus.WithField("user", lrPassword).Info("login")                          // want "log message may expose sensitive data"
+	lrLogger.WithField("user", lrUser).WithField("token", lrUser).Info("login") // want "log message may expose sensitive data"
+
+	// --- logrus.Fields map literals ---
+	logrus.WithFields(logrus.Fields{"user": lrUser, "attempt": 1}).Info("login")
+	logrus.WithFields(logrus.Fields{
+		"user":   lrUser,
+		"secret": lrUser,     // want "log message may expose sensitive data"
+		"pw":     lrPassword, // want "log message may expose sensitive data"
+	}).Info("login")
+	lrLogger.WithFields(lrFields).Info("login")
+}
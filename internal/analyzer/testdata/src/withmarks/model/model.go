@@ -0,0 +1,49 @@
+package model
+
+import "log/slog"
+
+type User struct {
+	Name     string
+	Password string `json:"password" lingo:"secret"` // want Password:"sensitive"
+}
+
+// APIToken is a bearer token issued to a client.
+//
+//lingo:sensitive
+type APIToken string // want APIToken:"sensitive"
+
+type Session struct {
+	ID    string
+	Token APIToken
+}
+
+// Account formats itself without its secret.
+type Account struct { // want Account:`redacts\(LogValue\)`
+	Login string
+	PIN   string `lingo:"secret"` // want PIN:"sensitive"
+}
+
+func (a Account) LogValue() slog.Value {
+	return slog.StringValue(a.Login)
+}
+
+// Leaky formats itself with its secret.
+type Leaky struct {
+	Login string
+	PIN   string `lingo:"secret"` // want PIN:"sensitive"
+}
+
+func (l Leaky) String() string {
+	return l.Login + ":" + l.PIN // want `String method may expose sensitive data: field PIN is marked sensitive`
+}
+
+// Encoded marshals itself for zap without its secret.
+type Encoded struct { // want Encoded:`redacts\(MarshalLogObject\)`
+	Login string
+	Key   APIToken
+}
+
+func (e *Encoded) MarshalLogObject(enc interface{ AddString(k, v string) }) error {
+	enc.AddString("login", e.Login)
+	return nil
+}
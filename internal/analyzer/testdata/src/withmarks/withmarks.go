@@ -0,0 +1,27 @@
+package withmarks
+
+import (
+	"log"
+	"log/slog"
+
+	"withmarks/model"
+)
+
+type request struct {
+	Session model.Session
+}
+
+func fMarks(u model.User, tok model.APIToken, s model.Session, a model.Account, l model.Leaky, e *model.Encoded) {
+	// --- tagged fields and annotated types ---
+	log.Print("user ", u.Password)         // want `variable "u.Password"` `"u.Password" is a sensitive field`
+	log.Printf("%+v", u)                   // want `"u" contains sensitive field Password`
+	slog.Info("issued", "t", tok)          // want `"tok" has sensitive type withmarks/model.APIToken`
+	slog.Info("session", slog.Any("s", s)) // want `"s" contains withmarks/model.APIToken in field Token`
+	log.Printf("%v", request{Session: s})  // want `contains withmarks/model.APIToken in field Session.Token`
+	log.Print("user ", u.Name)
+
+	// --- types that redact their sensitive fields ---
+	slog.Info("account", "a", a)
+	slog.Info("encoded", "e", e)
+	log.Printf("%v", l) // want `"l" contains sensitive field PIN`
+}
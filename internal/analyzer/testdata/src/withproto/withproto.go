@@ -0,0 +1,20 @@
+package withproto
+
+import (
+	"log"
+	"log/slog"
+
+	"withproto/userpb"
+)
+
+func fProto(req *userpb.LoginRequest, p *userpb.Profile) {
+	// --- whole messages holding debug_redact fields ---
+	slog.Info("login", "req", req)                      // want `"req" contains sensitive field Password`
+	log.Printf("login %v", req)                         // want `"req" contains sensitive field Password`
+	slog.Info("device", slog.Any("device", req.Device)) // want `"req.Device" contains sensitive field PushToken`
+	log.Print("profile ", p)                            // want `"p" contains sensitive field RecoveryCode`
+
+	// --- fields without the option ---
+	slog.Info("login", "user", req.GetUsername())
+	log.Printf("device %s", req.Device.Id)
+}
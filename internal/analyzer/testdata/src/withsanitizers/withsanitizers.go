@@ -0,0 +1,54 @@
+package withsanitizers
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+
+	"example.com/mask"
+)
+
+type Account struct { // want Account:`redacts\(String\)`
+	Email    string
+	Password string
+}
+
+func (a Account) String() string {
+	return mask.Email(a.Email) + " " + mask.Last4(a.Password)
+}
+
+func fSanitized(password, authToken string) {
+	// --- built-in sanitizers ---
+	log.Printf("password length: %d", len(password))
+	slog.Info("checked", "n", len(authToken))
+	log.Printf("hash %x", sha256.Sum256([]byte(password)))
+
+	// --- configured sanitizers ---
+	log.Printf("token: %s", mask.Last4(authToken))
+	slog.Info("masked", "t", mask.Last4(authToken))
+	log.Print("card " + mask.Last4(password) + " of " + fmt.Sprint(len(password)))
+
+	// --- a sanitizer does not cover what is outside it ---
+	log.Printf("token: %s (%d)", authToken, len(authToken)) // want `argument "authToken" is labelled "token"`
+	slog.Info("t", "v", mask.Last4(authToken)+authToken)    // want `variable "authToken" matches keyword "auth"`
+
+	// --- taint stops at a sanitizer ---
+	secret := os.Getenv("APP_SECRET")
+	masked := mask.Last4(secret)
+	log.Printf("using %s", masked)
+	log.Printf("using %s", secret) // want `"secret" matches keyword "secret"` `"secret" holds the environment variable APP_SECRET`
+}
+
+func fSanitizedInPlace() {
+	// --- a sanitizer assigned back to the variable clears it ---
+	v := os.Getenv("APP_SECRET")
+	v = mask.Last4(v)
+	log.Printf("using %s", v)
+
+	w := os.Getenv("APP_SECRET")
+	log.Printf("using %s", w) // want `"w" holds the environment variable APP_SECRET`
+	w = mask.Last4(w)
+	log.Printf("using %s", w)
+}
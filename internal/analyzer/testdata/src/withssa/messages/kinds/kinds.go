@@ -0,0 +1,11 @@
+package kinds
+
+// Kind is only imported by withssa indirectly, through messages.
+type Kind int
+
+func (k Kind) Label() string { // want Label:`messages\("plain", "Special!!"\)`
+	if k == 0 {
+		return "plain"
+	}
+	return "Special!!"
+}
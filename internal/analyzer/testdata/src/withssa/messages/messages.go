@@ -0,0 +1,22 @@
+package messages
+
+import "withssa/messages/kinds"
+
+func Ready() string { // want Ready:`messages\("server ready"\)`
+	return "server ready"
+}
+
+func Status(ok bool) string { // want Status:`messages\("done", "Failed!!"\)`
+	if ok {
+		return "done"
+	}
+	return "Failed!!"
+}
+
+func Describe(name string) string {
+	return "user " + name
+}
+
+func DefaultKind() kinds.Kind {
+	return 0
+}
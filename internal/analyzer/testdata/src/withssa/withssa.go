@@ -0,0 +1,47 @@
+package withssa
+
+import (
+	"log"
+	"log/slog"
+
+	"withssa/messages"
+)
+
+func startMsg() string { // want startMsg:`messages\("Starting"\)`
+	return "Starting"
+}
+
+func prefix(debug bool) string { // want prefix:`messages\("debug: ", ""\)`
+	if debug {
+		return "debug: "
+	}
+	return ""
+}
+
+func fSSA(failed, debug bool, name string) {
+	// --- values chosen across branches ---
+	msg := "ok"
+	if failed {
+		msg = "Failed!!"
+	}
+	log.Print(msg) // want `log message must start with a lowercase letter` `log message must not contain repeated punctuation`
+
+	// --- values returned by helpers ---
+	slog.Info(startMsg()) // want `log message must start with a lowercase letter`
+	slog.Info(prefix(debug) + "ready")
+	slog.Info(prefix(debug) + "Loaded") // want `log message must start with a lowercase letter`
+
+	// --- helpers in other packages, through facts ---
+	slog.Info(messages.Ready())
+	slog.Info(messages.Status(failed)) // want `log message must start with a lowercase letter` `repeated punctuation`
+	// the method of a type withssa does not import directly
+	slog.Info(messages.DefaultKind().Label()) // want `log message must start with a lowercase letter` `repeated punctuation`
+
+	// --- values that are not constant fall back to the syntactic checks ---
+	slog.Info(messages.Describe(name))
+	note := "secret stored"
+	if failed {
+		note = name
+	}
+	slog.Info(note)
+}
@@ -0,0 +1,35 @@
+package withwrappers
+
+import (
+	"context"
+
+	"example.com/applog"
+)
+
+var wwCtx = context.Background()
+var wwUser = "alice"
+var wwToken = "tok"
+
+func fWrappers() {
+	applog.Infof(wwCtx, "user %s logged in", wwUser)
+	applog.Infof(wwCtx, "User %s logged in", wwUser) // want `log message must start with a lowercase letter`
+	applog.Infof(wwCtx, "session %s", wwToken)       // want "log message may expose sensitive data"
+	applog.Event("Login", "user logged in")
+	applog.Event("login", "User logged in 🚀") // want `log message must start with a lowercase letter` `log message must not contain emoji`
+	applog.Notice("Disk almost full")         // want `log message must start with a lowercase letter`
+
+	var l applog.Logger
+	l.Warn("Cache miss!!") // want `log message must start with a lowercase letter` `log message must not contain repeated punctuation`
+
+	_ = applog.Describe("Alice")
+	localNotice("Cache warmed up") // want `log message must start with a lowercase letter`
+}
+
+// localNotice wraps a wrapper declared in another package.
+func localNotice(msg string) { // want localNotice:`logWrapper\(msg=0\)`
+	applog.Notice(msg)
+}
+
+func fLocal() {
+	localNotice("Cache cleared") // want `log message must start with a lowercase letter`
+}
@@ -15,7 +15,7 @@ func fZap() {
 	// --- SugaredLogger format methods ---
 	sugar := zapLog.Sugar()
 	sugar.Infof("Starting: %s", "world")        // want `log message must start with a lowercase letter`
-	sugar.Infof("user token: %s", zapUserToken) // want "log message may expose sensitive data" "log message may expose sensitive data"
+	sugar.Infof("user token: %s", zapUserToken) // want `log message may expose sensitive data: argument "zapUserToken" is labelled "token"`
 
 	// --- len(parts)==0: динамический аргумент ---
 	dynamicMsg := "hello"
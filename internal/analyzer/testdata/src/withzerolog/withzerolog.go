@@ -0,0 +1,39 @@
+package withzerolog
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+var zlUser = "alice"
+var zlToken = "tok"
+var zlErr = errors.New("boom")
+
+func fZerolog() {
+	// --- messages at the end of the chain ---
+	log.Info().Msg("server started")
+	log.Info().Msg("Server started")                       // want `log message must start with a lowercase letter`
+	log.Info().Str("user", zlUser).Msgf("User %s", zlUser) // want `log message must start with a lowercase letter`
+	log.Warn().Int("attempt", 3).Msg("retrying!!!")        // want `log message must not contain repeated punctuation`
+	log.Error().Err(zlErr).Msg("запрос не выполнен")       // want `log message must be in English`
+	log.Printf("Listening on %s", zlUser)                  // want `log message must start with a lowercase letter`
+
+	// --- sensitive fields anywhere in the chain ---
+	log.Info().Str("token", zlUser).Msg("login")                            // want "log message may expose sensitive data"
+	log.Info().Str("user", zlUser).Interface("pw", zlToken).Send()          // want "log message may expose sensitive data"
+	log.Debug().Any("secret", zlUser).Str("user", zlUser).Msg("x")          // want "log message may expose sensitive data"
+	log.Info().Dict("auth", zerolog.Dict().Str("user", zlUser)).Msg("x")    // want "log message may expose sensitive data"
+	log.Info().Dict("req", zerolog.Dict().Str("password", zlUser)).Msg("x") // want "log message may expose sensitive data"
+
+	// --- Logger methods and context fields ---
+	logger := zerolog.New()
+	logger.Info().Str("user", zlUser).Msg("ready")
+	logger.WithLevel(zerolog.WarnLevel).Msg("Low disk") // want `log message must start with a lowercase letter`
+	logger.Err(zlErr).Msg("Failed")                     // want `log message must start with a lowercase letter`
+	_ = logger.With().Str("api_key", zlUser).Logger()   // want "log message may expose sensitive data"
+
+	// --- chains without a terminal are not log statements ---
+	_ = log.Info().Str("Component", zlUser)
+}
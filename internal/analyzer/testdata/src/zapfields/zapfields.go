@@ -0,0 +1,35 @@
+package zapfields
+
+import "go.uber.org/zap"
+
+var zfLogger = zap.NewNop()
+var zfUser = "alice"
+var zfToken = "tok"
+var zfCreds = struct{ User string }{}
+var zfSecret = struct{ Value string }{}
+var zfUserField = zap.String("user", "alice")
+
+func fZapFields() {
+	// --- clean fields ---
+	zfLogger.Info("ok", zap.String("user", zfUser), zap.Int("attempt", 1))
+	zfLogger.Info("ok", zfUserField)
+
+	// --- Field constructors ---
+	zfLogger.Info("ok", zap.String("token", zfUser))                         // want "log message may expose sensitive data"
+	zfLogger.Info("ok", zap.String("user", zfToken))                         // want "log message may expose sensitive data"
+	zfLogger.Info("ok", zap.Any("credential", zfCreds))                      // want "log message may expose sensitive data"
+	zfLogger.Info("ok", zap.Object("secret", zfCreds))                       // want "log message may expose sensitive data"
+	zfLogger.Info("ok", zap.Reflect("user", zfCreds), zap.Namespace("auth")) // want "log message may expose sensitive data"
+	zfLogger.Info("ok", zap.Dict("req", zap.String("jwt", zfUser)))          // want "log message may expose sensitive data"
+	zfLogger.With(zap.Inline(zfSecret)).Info("ok")                           // want "log message may expose sensitive data"
+
+	// --- SugaredLogger *w key/value pairs ---
+	sugar := zfLogger.Sugar()
+	sugar.Infow("ok", "user", zfUser)
+	sugar.Infow("ok", "password", zfUser)                // want "log message may expose sensitive data"
+	sugar.Errorw("failed", "user", zfUser, "t", zfToken) // want "log message may expose sensitive data"
+	sugar.Infow("ok", zap.String("api_key", zfUser))     // want "log message may expose sensitive data"
+	sugar.With("user", zfUser).Infow("ok")
+	sugar.Infow("ok", "user", zfUser, "attempt") // want "log call has an odd number of key/value arguments"
+	sugar.With("user").Infow("ok")               // want "log call has an odd number of key/value arguments"
+}
@@ -0,0 +1,154 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/PriestFaria/lingo/internal/config"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// wrapperFact is exported for a function that forwards one of its string
+// parameters as the message of a log call, either directly or through another
+// wrapper. Calls to such a function, in any package, are analysed as log calls.
+type wrapperFact struct {
+	// Msg is the index of the forwarded message parameter.
+	Msg int
+	// Format is true when the message is forwarded as a format string together
+	// with the function's variadic operands.
+	Format bool
+	// Level is the severity of the wrapped log call, if known.
+	Level string
+}
+
+func (*wrapperFact) AFact() {}
+
+func (f *wrapperFact) String() string {
+	if f.Format {
+		return fmt.Sprintf("logWrapper(format=%d)", f.Msg)
+	}
+	return fmt.Sprintf("logWrapper(msg=%d)", f.Msg)
+}
+
+// logFunc describes a call to the wrapper in the same terms as the built-in
+// logging APIs.
+func (f *wrapperFact) logFunc() logFunc {
+	return logFunc{Kind: emitCall, Msg: f.Msg, Format: f.Format, Args: noArg, Level: f.Level}
+}
+
+// exportWrapperFacts finds the functions and methods of the package under
+// analysis that forward a string parameter as a log message and exports a
+// wrapperFact for each. Wrappers of wrappers declared in the same package are
+// found by iterating until no new fact appears.
+func exportWrapperFacts(pass *analysis.Pass, cfg *config.Config) {
+	type candidate struct {
+		fn   *types.Func
+		decl *ast.FuncDecl
+	}
+	var candidates []candidate
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Body == nil {
+				continue
+			}
+			if fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func); ok {
+				candidates = append(candidates, candidate{fn, fd})
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, c := range candidates {
+			if pass.ImportObjectFact(c.fn, new(wrapperFact)) {
+				continue
+			}
+			if fact := wrapperOf(pass, c.fn, c.decl, cfg); fact != nil {
+				pass.ExportObjectFact(c.fn, fact)
+				changed = true
+			}
+		}
+	}
+}
+
+// wrapperOf returns the wrapperFact for fn if its body passes one of its
+// string parameters as the message of a recognised log call, or nil.
+func wrapperOf(pass *analysis.Pass, fn *types.Func, decl *ast.FuncDecl, cfg *config.Config) *wrapperFact {
+	sig := fn.Type().(*types.Signature)
+	var fact *wrapperFact
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if fact != nil {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		inner, ok := resolveEmitter(pass, call, cfg)
+		if !ok || inner.Msg == noArg || inner.Msg >= len(call.Args) {
+			return true
+		}
+		index := paramIndex(pass.TypesInfo, sig, call.Args[inner.Msg])
+		if index < 0 || !isStringType(sig.Params().At(index).Type()) {
+			return true
+		}
+		fact = &wrapperFact{Msg: index, Level: inner.Level}
+		if inner.Format && sig.Variadic() && call.Ellipsis.IsValid() {
+			fact.Format = paramIndex(pass.TypesInfo, sig, call.Args[len(call.Args)-1]) == sig.Params().Len()-1
+		}
+		return false
+	})
+	return fact
+}
+
+// paramIndex returns the index of the parameter of sig that expr refers to,
+// or -1 when expr is not a bare parameter reference.
+func paramIndex(info *types.Info, sig *types.Signature, expr ast.Expr) int {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok {
+		return -1
+	}
+	v, ok := info.Uses[ident].(*types.Var)
+	if !ok {
+		return -1
+	}
+	for i := 0; i < sig.Params().Len(); i++ {
+		if sig.Params().At(i) == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// resolveWrapper describes call when its callee carries a wrapperFact.
+func resolveWrapper(pass *analysis.Pass, call *ast.CallExpr) (logFunc, bool) {
+	callee, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || callee.Pkg() == nil {
+		return logFunc{}, false
+	}
+	var fact wrapperFact
+	if !pass.ImportObjectFact(callee.Origin(), &fact) {
+		return logFunc{}, false
+	}
+	return fact.logFunc(), true
+}
+
+// resolveEmitter describes call when it writes a log record: an emitting
+// function of a built-in API, a configured custom logger, a method of a
+// logger-shaped interface or a known wrapper.
+func resolveEmitter(pass *analysis.Pass, call *ast.CallExpr, cfg *config.Config) (logFunc, bool) {
+	if _, fn, ok := resolveLogFunc(pass.TypesInfo, call); ok {
+		return fn, fn.Kind == emitCall
+	}
+	if fn, ok := resolveCustomLogFunc(pass.TypesInfo, call, cfg.Loggers); ok {
+		return fn, true
+	}
+	if fn, ok := resolveInterfaceLogFunc(pass, call); ok {
+		return fn, true
+	}
+	return resolveWrapper(pass, call)
+}
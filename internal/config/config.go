@@ -4,19 +4,39 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path"
+	"slices"
 )
 
 // FiltersConfig manages enabling/disabling of individual filters.
-// A nil *bool means "not configured" and defaults to enabled.
+// A nil *bool means "not configured" and defaults to enabled, except for PII,
+// which is opt-in.
 type FiltersConfig struct {
-    FirstLetter *bool `json:"first_letter"`
-    English     *bool `json:"english"`
-    Emoji       *bool `json:"emoji"`
-    Security    *bool `json:"security"`
+    FirstLetter  *bool `json:"first_letter"`
+    English      *bool `json:"english"`
+    Emoji        *bool `json:"emoji"`
+    Security     *bool `json:"security"`
+    // LogInjection reports untrusted input in the message text of a log
+    // call (CWE-117).
+    LogInjection *bool `json:"log_injection"`
+    // PII reports personal data: email addresses, phone numbers, card
+    // numbers and the like in literals, and identifiers such as email or ssn.
+    // Its detectors are broad, so it is disabled unless set to true.
+    PII          *bool `json:"pii"`
+    // SkipLevels are log levels — "trace", "debug", "info", "warn",
+    // "error", "fatal" or "panic" — whose messages the style filters
+    // (first_letter, english, emoji) do not check. Calls whose level is
+    // unknown, such as log.Print, are always checked.
+    SkipLevels   []string `json:"skip_levels"`
 }
 
+// levels are the log levels SkipLevels may name.
+var levels = []string{"trace", "debug", "info", "warn", "error", "fatal", "panic"}
+
 // IsEnabled returns true if the named filter is enabled.
-// Recognised names: "first_letter", "english", "emoji", "security".
+// Recognised names: "first_letter", "english", "emoji", "security",
+// "log_injection", "pii". An unset filter is enabled, except "pii", which is
+// enabled only when set to true.
 func (f *FiltersConfig) IsEnabled(name string) bool {
     var p *bool
     switch name {
@@ -28,6 +48,10 @@ func (f *FiltersConfig) IsEnabled(name string) bool {
         p = f.Emoji
     case "security":
         p = f.Security
+    case "log_injection":
+        p = f.LogInjection
+    case "pii":
+        return f.PII != nil && *f.PII
     }
     return p == nil || *p
 }
@@ -37,6 +61,84 @@ type SecurityConfig struct {
     // ExtraKeywords are additional sensitive keywords beyond the built-in list.
     // Matching is case-insensitive: "CVV" and "cvv" are equivalent.
     ExtraKeywords []string `json:"extra_keywords"`
+    // LowConfidence also reports keywords that a format string only mentions
+    // in prose, e.g. "token expired after %d retries", rather than as the
+    // label of a formatted value.
+    LowConfidence bool `json:"low_confidence"`
+    // SensitiveTypes are additional types, written "import/path.Name", whose
+    // values must not be logged, e.g. "example.com/auth.Session".
+    SensitiveTypes []string `json:"sensitive_types"`
+    // IgnoreTypes removes types from the built-in sensitive type catalogue.
+    IgnoreTypes []string `json:"ignore_types"`
+    // InferJSONDash treats struct fields tagged json:"-" as sensitive, like
+    // fields tagged lingo:"secret".
+    InferJSONDash bool `json:"infer_json_dash"`
+    // TaintSources are additional functions whose first result is sensitive,
+    // written as types.Func.FullName prints them: "example.com/vault.Fetch"
+    // or "(*example.com/vault.Client).Secret".
+    TaintSources []string `json:"taint_sources"`
+    // Sanitizers are functions whose result is safe to log whatever their
+    // arguments, added to the built-in ones (len, cap, crypto/sha256.Sum256,
+    // …). Names are fully qualified: "example.com/redact.String".
+    Sanitizers []string `json:"sanitizers"`
+    // SecretPatterns enables or disables, by name, the entries of the secret
+    // catalogue literals are scanned with: "aws_access_key", "github_token",
+    // "slack_webhook", "slack_token", "jwt", "private_key" and
+    // "high_entropy". An absent entry is enabled.
+    SecretPatterns map[string]bool `json:"secret_patterns"`
+    // EntropyThreshold is the Shannon entropy, in bits per character, from
+    // which a long token of a literal is reported as a secret. Zero selects
+    // the default, 4.5.
+    EntropyThreshold float64 `json:"entropy_threshold"`
+}
+
+// PIIConfig holds settings for PIIFilter.
+type PIIConfig struct {
+    // ExtraKeywords are additional personal data keywords beyond the
+    // built-in list (email, ssn, dob, phone, address, …).
+    ExtraKeywords []string `json:"extra_keywords"`
+    // IgnoreKeywords removes keywords from the built-in list, e.g. "address"
+    // in network code.
+    IgnoreKeywords []string `json:"ignore_keywords"`
+    // Patterns enables or disables, by name, the detectors literals are
+    // scanned with: "email", "phone", "ip_address", "credit_card", "iban"
+    // and "passport". An absent entry is enabled.
+    Patterns map[string]bool `json:"patterns"`
+}
+
+// LoggerConfig declares a project-specific logging function or method (for
+// example a wrapper around a standard logger) that lingo analyses like the
+// built-in loggers.
+type LoggerConfig struct {
+	// Package is the import path declaring the function or method.
+	Package string `json:"package"`
+	// Receiver is the receiver type name for methods, e.g. "Logger" for
+	// (*obs.Logger).Event. Empty matches package-level functions.
+	Receiver string `json:"receiver"`
+	// Methods are function name patterns in path.Match syntax, e.g. "Info*".
+	Methods []string `json:"methods"`
+	// MessageIndex is the index of the message (or format string) argument.
+	MessageIndex int `json:"message_index"`
+	// Printf marks the message as a printf-style format string whose operands
+	// follow it.
+	Printf bool `json:"printf"`
+	// ArgsIndex is the index of the first key/value or field argument.
+	// Nil means the function takes none.
+	ArgsIndex *int `json:"args_index"`
+}
+
+// Matches reports whether the function name (declared in pkgPath, with receiver
+// type recv or "" for package-level functions) is covered by l.
+func (l *LoggerConfig) Matches(pkgPath, recv, name string) bool {
+	if l.Package != pkgPath || l.Receiver != recv {
+		return false
+	}
+	for _, pattern := range l.Methods {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
 }
 
 // Config is the root configuration structure for a .lingo.json file.
@@ -45,11 +147,55 @@ type SecurityConfig struct {
 //
 //	{
 //	  "filters": { "first_letter": false },
-//	  "security": { "extra_keywords": ["cvv", "ssn"] }
+//	  "security": { "extra_keywords": ["cvv", "ssn"] },
+//	  "pii": { "ignore_keywords": ["address"] },
+//	  "loggers": [
+//	    { "package": "example.com/ourlog", "methods": ["Infof", "Errorf"],
+//	      "message_index": 1, "printf": true }
+//	  ],
+//	  "ssa": true
 //	}
 type Config struct {
     Filters  FiltersConfig  `json:"filters"`
     Security SecurityConfig `json:"security"`
+    PII      PIIConfig      `json:"pii"`
+    Loggers  []LoggerConfig `json:"loggers"`
+    // SSA enables the SSA-backed analysis of messages that are chosen across
+    // branches or returned by helper functions. It is slower and off by default.
+    SSA      bool           `json:"ssa"`
+}
+
+// validate reports configuration values that cannot be used, such as a
+// custom logger without a package or with a malformed method pattern.
+func (c *Config) validate() error {
+	for _, level := range c.Filters.SkipLevels {
+		if !slices.Contains(levels, level) {
+			return fmt.Errorf("filters: unknown level %q in skip_levels", level)
+		}
+	}
+	if t := c.Security.EntropyThreshold; t < 0 || t > 8 {
+		return fmt.Errorf("security: entropy_threshold must be between 0 and 8, got %v", t)
+	}
+	for i, l := range c.Loggers {
+		if l.Package == "" {
+			return fmt.Errorf("loggers[%d]: package is required", i)
+		}
+		if len(l.Methods) == 0 {
+			return fmt.Errorf("loggers[%d]: methods is required", i)
+		}
+		for _, pattern := range l.Methods {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("loggers[%d]: bad method pattern %q: %w", i, pattern, err)
+			}
+		}
+		if l.MessageIndex < 0 {
+			return fmt.Errorf("loggers[%d]: message_index must not be negative", i)
+		}
+		if l.ArgsIndex != nil && *l.ArgsIndex <= l.MessageIndex {
+			return fmt.Errorf("loggers[%d]: args_index must be greater than message_index", i)
+		}
+	}
+	return nil
 }
 
 // Default returns the default configuration:
@@ -86,6 +232,9 @@ func FromMap(m map[string]any) (*Config, error) {
 	if err := json.Unmarshal(data, &cfg); err != nil {
 		return nil, fmt.Errorf("lingo: cannot parse settings map: %w", err)
 	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("lingo: invalid settings: %w", err)
+	}
 
 	return &cfg, nil
 }
@@ -107,6 +256,9 @@ func Load(path string) (*Config, error) {
     if err := json.Unmarshal(data, &cfg); err != nil {
         return nil, fmt.Errorf("lingo: cannot parse config %q: %w", path, err)
     }
+    if err := cfg.validate(); err != nil {
+        return nil, fmt.Errorf("lingo: invalid config %q: %w", path, err)
+    }
 
     return &cfg, nil
 }
\ No newline at end of file
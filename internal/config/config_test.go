@@ -15,11 +15,14 @@ func TestLoad_EmptyPath_ReturnsDefault(t *testing.T) {
         t.Fatalf("unexpected error: %v", err)
     }
 
-    for _, name := range []string{"first_letter", "english", "emoji", "security"} {
+    for _, name := range []string{"first_letter", "english", "emoji", "security", "log_injection"} {
         if !cfg.Filters.IsEnabled(name) {
             t.Errorf("filter %q should be enabled by default", name)
         }
     }
+    if cfg.Filters.IsEnabled("pii") {
+        t.Error("filter \"pii\" should be disabled by default")
+    }
 
     if len(cfg.Security.ExtraKeywords) != 0 {
         t.Errorf("expected no extra_keywords by default, got %v", cfg.Security.ExtraKeywords)
@@ -50,10 +53,11 @@ func TestLoad_InvalidJSON_ReturnsError(t *testing.T) {
 func TestLoad_AllFiltersDisabled(t *testing.T) {
     content := `{
         "filters": {
-            "first_letter": false,
-            "english":      false,
-            "emoji":        false,
-            "security":     false
+            "first_letter":  false,
+            "english":       false,
+            "emoji":         false,
+            "security":      false,
+            "log_injection": false
         }
     }`
     path := writeTemp(t, content)
@@ -63,7 +67,7 @@ func TestLoad_AllFiltersDisabled(t *testing.T) {
         t.Fatalf("unexpected error: %v", err)
     }
 
-    for _, name := range []string{"first_letter", "english", "emoji", "security"} {
+    for _, name := range []string{"first_letter", "english", "emoji", "security", "log_injection"} {
         if cfg.Filters.IsEnabled(name) {
             t.Errorf("filter %q should be disabled", name)
         }
@@ -108,6 +112,201 @@ func TestLoad_ExtraKeywords(t *testing.T) {
     }
 }
 
+func TestLoad_SensitiveTypes(t *testing.T) {
+    path := writeTemp(t, `{"security": {
+        "sensitive_types": ["example.com/auth.Session"],
+        "ignore_types": ["net/http.Header"]
+    }}`)
+
+    cfg, err := config.Load(path)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if len(cfg.Security.SensitiveTypes) != 1 || len(cfg.Security.IgnoreTypes) != 1 {
+        t.Errorf("unexpected security config: %+v", cfg.Security)
+    }
+}
+
+func TestLoad_TaintSources(t *testing.T) {
+    path := writeTemp(t, `{"security": {"taint_sources": ["(*example.com/vault.Client).Secret"]}}`)
+
+    cfg, err := config.Load(path)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if len(cfg.Security.TaintSources) != 1 || cfg.Security.TaintSources[0] != "(*example.com/vault.Client).Secret" {
+        t.Errorf("unexpected taint sources: %v", cfg.Security.TaintSources)
+    }
+}
+
+func TestLoad_SecretPatterns(t *testing.T) {
+    path := writeTemp(t, `{"security": {"secret_patterns": {"jwt": false}, "entropy_threshold": 5}}`)
+
+    cfg, err := config.Load(path)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if enabled, ok := cfg.Security.SecretPatterns["jwt"]; !ok || enabled {
+        t.Errorf("expected jwt pattern to be disabled, got %v", cfg.Security.SecretPatterns)
+    }
+    if cfg.Security.EntropyThreshold != 5 {
+        t.Errorf("expected entropy_threshold 5, got %v", cfg.Security.EntropyThreshold)
+    }
+}
+
+func TestLoad_InvalidEntropyThreshold_ReturnsError(t *testing.T) {
+    for _, threshold := range []string{"-1", "9"} {
+        path := writeTemp(t, `{"security": {"entropy_threshold": `+threshold+`}}`)
+        if _, err := config.Load(path); err == nil {
+            t.Errorf("expected error for entropy_threshold %s, got nil", threshold)
+        }
+    }
+}
+
+func TestLoad_SkipLevels(t *testing.T) {
+    path := writeTemp(t, `{"filters": {"skip_levels": ["debug", "trace"]}}`)
+
+    cfg, err := config.Load(path)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if len(cfg.Filters.SkipLevels) != 2 || cfg.Filters.SkipLevels[0] != "debug" {
+        t.Errorf("unexpected skip_levels: %v", cfg.Filters.SkipLevels)
+    }
+}
+
+func TestLoad_UnknownSkipLevel_ReturnsError(t *testing.T) {
+    path := writeTemp(t, `{"filters": {"skip_levels": ["verbose"]}}`)
+    if _, err := config.Load(path); err == nil {
+        t.Error("expected error for unknown level, got nil")
+    }
+}
+
+// TestLoad_PIIAbsent_StaysDisabled checks the one exception to the nil means
+// enabled convention: a config that leaves pii out keeps it disabled, while
+// the other absent filters are enabled.
+func TestLoad_PIIAbsent_StaysDisabled(t *testing.T) {
+    path := writeTemp(t, `{"filters": {"emoji": false}, "pii": {"extra_keywords": ["surname"]}}`)
+
+    cfg, err := config.Load(path)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if cfg.Filters.IsEnabled("pii") {
+        t.Error("pii should stay disabled when the filters section leaves it out")
+    }
+    if !cfg.Filters.IsEnabled("security") {
+        t.Error("security should be enabled when the filters section leaves it out")
+    }
+}
+
+func TestLoad_PII(t *testing.T) {
+    path := writeTemp(t, `{
+        "filters": {"security": false, "pii": true},
+        "pii": {
+            "extra_keywords": ["surname"],
+            "ignore_keywords": ["address"],
+            "patterns": {"ip_address": false}
+        }
+    }`)
+
+    cfg, err := config.Load(path)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !cfg.Filters.IsEnabled("pii") {
+        t.Error("pii should stay enabled when only security is disabled")
+    }
+    if len(cfg.PII.ExtraKeywords) != 1 || len(cfg.PII.IgnoreKeywords) != 1 {
+        t.Errorf("unexpected pii keywords: %+v", cfg.PII)
+    }
+    if enabled, ok := cfg.PII.Patterns["ip_address"]; !ok || enabled {
+        t.Errorf("expected ip_address pattern to be disabled, got %v", cfg.PII.Patterns)
+    }
+}
+
+func TestFromMap_Sanitizers(t *testing.T) {
+    cfg, err := config.FromMap(map[string]any{
+        "security": map[string]any{"sanitizers": []any{"example.com/redact.String"}},
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if len(cfg.Security.Sanitizers) != 1 || cfg.Security.Sanitizers[0] != "example.com/redact.String" {
+        t.Errorf("unexpected sanitizers: %v", cfg.Security.Sanitizers)
+    }
+}
+
+func TestFromMap_InferJSONDash(t *testing.T) {
+    cfg, err := config.FromMap(map[string]any{
+        "security": map[string]any{"infer_json_dash": true},
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !cfg.Security.InferJSONDash {
+        t.Error("expected infer_json_dash to be enabled")
+    }
+}
+
+func TestLoad_LowConfidence(t *testing.T) {
+    path := writeTemp(t, `{"security": {"low_confidence": true}}`)
+
+    cfg, err := config.Load(path)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !cfg.Security.LowConfidence {
+        t.Error("expected low_confidence to be enabled")
+    }
+}
+
+func TestLoad_Loggers(t *testing.T) {
+    content := `{
+        "loggers": [
+            {"package": "example.com/ourlog", "methods": ["Info*"], "message_index": 1, "printf": true},
+            {"package": "example.com/obs", "receiver": "Logger", "methods": ["Event"], "message_index": 1, "args_index": 2}
+        ]
+    }`
+    path := writeTemp(t, content)
+
+    cfg, err := config.Load(path)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if len(cfg.Loggers) != 2 {
+        t.Fatalf("expected 2 loggers, got %d", len(cfg.Loggers))
+    }
+    if !cfg.Loggers[0].Matches("example.com/ourlog", "", "Infof") {
+        t.Error("Info* should match ourlog.Infof")
+    }
+    if cfg.Loggers[0].Matches("example.com/ourlog", "Logger", "Infof") {
+        t.Error("package-level logger should not match a method")
+    }
+    if cfg.Loggers[1].ArgsIndex == nil || *cfg.Loggers[1].ArgsIndex != 2 {
+        t.Errorf("expected args_index 2, got %v", cfg.Loggers[1].ArgsIndex)
+    }
+    if cfg.Loggers[0].ArgsIndex != nil {
+        t.Errorf("expected no args_index, got %v", *cfg.Loggers[0].ArgsIndex)
+    }
+}
+
+func TestLoad_InvalidLoggers_ReturnsError(t *testing.T) {
+    for name, content := range map[string]string{
+        "missing package": `{"loggers": [{"methods": ["Info"]}]}`,
+        "missing methods": `{"loggers": [{"package": "example.com/ourlog"}]}`,
+        "bad pattern":     `{"loggers": [{"package": "example.com/ourlog", "methods": ["Info["]}]}`,
+        "args before msg": `{"loggers": [{"package": "example.com/ourlog", "methods": ["Info"], "message_index": 1, "args_index": 0}]}`,
+    } {
+        t.Run(name, func(t *testing.T) {
+            if _, err := config.Load(writeTemp(t, content)); err == nil {
+                t.Fatal("expected error, got nil")
+            }
+        })
+    }
+}
+
 func TestLoad_EmptyJSON_AllDefaults(t *testing.T) {
     path := writeTemp(t, `{}`)
 
@@ -116,7 +315,7 @@ func TestLoad_EmptyJSON_AllDefaults(t *testing.T) {
         t.Fatalf("unexpected error: %v", err)
     }
 
-    for _, name := range []string{"first_letter", "english", "emoji", "security"} {
+    for _, name := range []string{"first_letter", "english", "emoji", "security", "log_injection"} {
         if !cfg.Filters.IsEnabled(name) {
             t.Errorf("filter %q should be enabled when JSON is empty", name)
         }
@@ -142,7 +341,7 @@ func TestDefault_RoundTrip(t *testing.T) {
         t.Fatalf("unmarshal failed: %v", err)
     }
 
-    for _, name := range []string{"first_letter", "english", "emoji", "security"} {
+    for _, name := range []string{"first_letter", "english", "emoji", "security", "log_injection"} {
         if !restored.Filters.IsEnabled(name) {
             t.Errorf("filter %q should be enabled after round-trip", name)
         }
@@ -167,7 +366,7 @@ func TestFromMap_Empty_ReturnsDefault(t *testing.T) {
     if err != nil {
         t.Fatalf("unexpected error: %v", err)
     }
-    for _, name := range []string{"first_letter", "english", "emoji", "security"} {
+    for _, name := range []string{"first_letter", "english", "emoji", "security", "log_injection"} {
         if !cfg.Filters.IsEnabled(name) {
             t.Errorf("filter %q should be enabled by default", name)
         }
@@ -215,26 +414,59 @@ func TestFromMap_InlineExtraKeywords(t *testing.T) {
     if len(cfg.Security.ExtraKeywords) != 3 {
         t.Fatalf("expected 3 extra_keywords, got %d", len(cfg.Security.ExtraKeywords))
     }
-    for _, name := range []string{"first_letter", "english", "emoji", "security"} {
+    for _, name := range []string{"first_letter", "english", "emoji", "security", "log_injection"} {
         if !cfg.Filters.IsEnabled(name) {
             t.Errorf("filter %q should be enabled by default", name)
         }
     }
 }
 
+func TestFromMap_InlineLoggers(t *testing.T) {
+    cfg, err := config.FromMap(map[string]any{
+        "loggers": []any{
+            map[string]any{
+                "package":       "example.com/ourlog",
+                "methods":       []any{"Infof"},
+                "message_index": 1,
+                "printf":        true,
+            },
+        },
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if len(cfg.Loggers) != 1 || !cfg.Loggers[0].Printf || cfg.Loggers[0].MessageIndex != 1 {
+        t.Errorf("unexpected loggers: %+v", cfg.Loggers)
+    }
+}
+
+func TestFromMap_InlineSSA(t *testing.T) {
+    cfg, err := config.FromMap(map[string]any{"ssa": true})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !cfg.SSA {
+        t.Error("expected ssa to be enabled")
+    }
+    if config.Default().SSA {
+        t.Error("expected ssa to be disabled by default")
+    }
+}
+
 func TestFromMap_AllFiltersDisabled(t *testing.T) {
     cfg, err := config.FromMap(map[string]any{
         "filters": map[string]any{
-            "first_letter": false,
-            "english":      false,
-            "emoji":        false,
-            "security":     false,
+            "first_letter":  false,
+            "english":       false,
+            "emoji":         false,
+            "security":      false,
+            "log_injection": false,
         },
     })
     if err != nil {
         t.Fatalf("unexpected error: %v", err)
     }
-    for _, name := range []string{"first_letter", "english", "emoji", "security"} {
+    for _, name := range []string{"first_letter", "english", "emoji", "security", "log_injection"} {
         if cfg.Filters.IsEnabled(name) {
             t.Errorf("filter %q should be disabled", name)
         }
@@ -249,7 +481,7 @@ func TestFromMap_UnknownKeysIgnored(t *testing.T) {
     if err != nil {
         t.Fatalf("unexpected error: %v", err)
     }
-    for _, name := range []string{"first_letter", "english", "emoji", "security"} {
+    for _, name := range []string{"first_letter", "english", "emoji", "security", "log_injection"} {
         if !cfg.Filters.IsEnabled(name) {
             t.Errorf("filter %q should be enabled (unknown keys ignored)", name)
         }
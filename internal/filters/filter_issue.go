@@ -13,6 +13,9 @@ type IssueFix struct {
 	End token.Pos
 	// NewText is the replacement string.
 	NewText string
+	// Imports are the import paths NewText refers to. The analyzer adds those
+	// the file does not import yet.
+	Imports []string
 }
 
 // FilterIssue represents a single violation found by a LogFilter.
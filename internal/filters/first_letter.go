@@ -9,7 +9,10 @@ import (
 )
 
 // FirstLetterFilter reports log messages whose first literal part starts with
-// an uppercase letter and provides an automatic fix to lowercase it.
+// an uppercase letter and provides an automatic fix to lowercase it. For a part
+// resolved from a constant or variable the fix edits its declaring literal,
+// and is omitted unless the call is the literal's only use in the same file,
+// as the edit would change every other use too.
 type FirstLetterFilter struct{}
 
 func (f *FirstLetterFilter) Apply(context *log.LogContext) []FilterIssue {
@@ -26,17 +29,27 @@ func (f *FirstLetterFilter) Apply(context *log.LogContext) []FilterIssue {
 			break 
 		}
 
-		contentStart := part.Pos + 1
-		return []FilterIssue{{
+		issue := FilterIssue{
 			Message: "log message must start with a lowercase letter",
 			Pos:     part.Pos,
-			Fix: &IssueFix{
+		}
+		literal := part.Pos
+		if part.Name != "" {
+			literal = token.NoPos
+			if part.SoleUse {
+				literal = part.Decl
+			}
+		}
+		if literal.IsValid() {
+			contentStart := literal + 1
+			issue.Fix = &IssueFix{
 				Message: "lowercase first letter",
 				Pos:     contentStart,
 				End:     token.Pos(int(contentStart) + size),
 				NewText: string(unicode.ToLower(firstRune)),
-			},
-		}}
+			}
+		}
+		return []FilterIssue{issue}
 	}
 	return nil
 }
\ No newline at end of file
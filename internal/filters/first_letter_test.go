@@ -2,6 +2,8 @@ package filters
 
 import (
 	"testing"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
 )
 
 func TestFirstLetterFilter(t *testing.T) {
@@ -107,3 +109,42 @@ func TestFirstLetterFilter_AllNonLiterals(t *testing.T) {
 		t.Errorf("got %d issues, want 0 when all parts are non-literals", len(issues))
 	}
 }
+
+func TestFirstLetterFilter_ResolvedPart_FixAtDeclaration(t *testing.T) {
+	f := &FirstLetterFilter{}
+	parts := []log.LogPart{{Value: "Starting", IsLiteral: true, Name: "startMsg", Decl: 500, SoleUse: true, Pos: 100, End: 108}}
+	issues := f.Apply(makeCtx(parts))
+	if len(issues) != 1 {
+		t.Fatalf("got %d issues, want 1", len(issues))
+	}
+	if issues[0].Pos != 100 {
+		t.Errorf("issue Pos = %d, want call-site position 100", issues[0].Pos)
+	}
+	if issues[0].Fix == nil || issues[0].Fix.Pos != 501 {
+		t.Errorf("expected Fix inside the declaring literal at 501, got %+v", issues[0].Fix)
+	}
+}
+
+func TestFirstLetterFilter_ResolvedPart_SharedDeclaration_NoFix(t *testing.T) {
+	f := &FirstLetterFilter{}
+	parts := []log.LogPart{{Value: "Starting", IsLiteral: true, Name: "startMsg", Decl: 500, Pos: 100, End: 108}}
+	issues := f.Apply(makeCtx(parts))
+	if len(issues) != 1 {
+		t.Fatalf("got %d issues, want 1", len(issues))
+	}
+	if issues[0].Fix != nil {
+		t.Errorf("expected no Fix for a declaration with other uses, got %+v", issues[0].Fix)
+	}
+}
+
+func TestFirstLetterFilter_ResolvedPart_NoDeclaration(t *testing.T) {
+	f := &FirstLetterFilter{}
+	parts := []log.LogPart{{Value: "GET", IsLiteral: true, Name: "MethodGet", Pos: 100, End: 109}}
+	issues := f.Apply(makeCtx(parts))
+	if len(issues) != 1 {
+		t.Fatalf("got %d issues, want 1", len(issues))
+	}
+	if issues[0].Fix != nil {
+		t.Errorf("expected no Fix without a declaring literal, got %+v", issues[0].Fix)
+	}
+}
@@ -0,0 +1,121 @@
+package filters
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+)
+
+// LogInjectionFilter reports untrusted input — request fields, parameters,
+// headers, lines read from a terminal — written verbatim into the text of a
+// log message, where a line break or an escape sequence in it can forge log
+// entries (CWE-117). The analyzer records in LogPart.Input which parts hold
+// such input.
+//
+// A value formatted with %q, or with another verb that does not print its
+// text as is, is not reported. A %s or %v verb written in a string literal of
+// the call comes with a fix replacing it with %q.
+//
+// Security, when set, is the sensitive-data filter run over the same
+// messages: a part it already reports, such as a cookie value or an
+// Authorization header, is not reported again as untrusted input.
+type LogInjectionFilter struct {
+	Security *SecurityFilter
+}
+
+func (f *LogInjectionFilter) Apply(context *log.LogContext) []FilterIssue {
+	var issues []FilterIssue
+	var sensitive argSpans
+	checked := false
+	for _, part := range context.Parts {
+		if part.IsLiteral || part.Input == "" {
+			continue
+		}
+		if !checked {
+			sensitive, checked = f.sensitiveParts(context), true
+		}
+		if sensitive.contains(part.Pos) {
+			continue
+		}
+		format, verb, ok := formattingVerb(context, part)
+		if ok && !verbatim(verb.Verb) {
+			continue
+		}
+		issue := FilterIssue{
+			Message: fmt.Sprintf("log message text includes untrusted input: %q holds %s; format it with %%q or log it as a structured attribute", part.Value, part.Input),
+			Pos:     part.Pos,
+		}
+		if ok {
+			issue.Fix = quoteVerbFix(context, format, verb)
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// sensitiveParts returns the arguments of context that Security reports.
+func (f *LogInjectionFilter) sensitiveParts(context *log.LogContext) argSpans {
+	var sensitive argSpans
+	if f.Security == nil {
+		return sensitive
+	}
+	for _, issue := range f.Security.Apply(context) {
+		sensitive.add(context, issue.Pos)
+	}
+	return sensitive
+}
+
+// verbatim reports whether verb prints a string argument as is: %s, or %v
+// without the '#' flag.
+func verbatim(verb string) bool {
+	switch verb[len(verb)-1] {
+	case 's':
+		return true
+	case 'v':
+		return !strings.Contains(verb, "#")
+	}
+	return false
+}
+
+// formattingVerb returns the format string part of context and its verb that
+// formats part, if any.
+func formattingVerb(context *log.LogContext, part log.LogPart) (log.LogPart, log.FormatVerb, bool) {
+	for _, format := range context.Parts {
+		for _, verb := range format.Verbs {
+			if verb.Pos.IsValid() && verb.Pos == part.Pos {
+				return format, verb, true
+			}
+		}
+	}
+	return log.LogPart{}, log.FormatVerb{}, false
+}
+
+// quoteVerbFix returns a fix replacing verb with %q, or nil when the format
+// string is not a literal of the call or has escape sequences before verb.
+func quoteVerbFix(context *log.LogContext, format log.LogPart, verb log.FormatVerb) *IssueFix {
+	if context.CallExpr == nil {
+		return nil
+	}
+	var lit *ast.BasicLit
+	ast.Inspect(context.CallExpr, func(n ast.Node) bool {
+		if l, ok := n.(*ast.BasicLit); ok && l.Kind == token.STRING && l.Pos() == format.Pos {
+			lit = l
+		}
+		return lit == nil
+	})
+	end := verb.Offset + len(verb.Verb)
+	if lit == nil || end > len(format.Value) || !strings.HasPrefix(lit.Value[1:], format.Value[:end]) {
+		return nil
+	}
+	// The verb letter follows the opening quote and the text before it.
+	pos := lit.Pos() + token.Pos(end)
+	return &IssueFix{
+		Message: fmt.Sprintf("format the value with %%q instead of %s", verb.Verb),
+		Pos:     pos,
+		End:     pos + 1,
+		NewText: "q",
+	}
+}
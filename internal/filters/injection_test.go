@@ -0,0 +1,69 @@
+package filters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+)
+
+func TestLogInjectionFilter(t *testing.T) {
+	f := &LogInjectionFilter{}
+
+	tests := []struct {
+		name       string
+		verb       string
+		input      string
+		wantIssues int
+	}{
+		{name: "untrusted input with %s — issue", verb: "%s", input: "a request parameter", wantIssues: 1},
+		{name: "untrusted input with %v — issue", verb: "%v", input: "a request parameter", wantIssues: 1},
+		{name: "untrusted input with %-10s — issue", verb: "%-10s", input: "a request parameter", wantIssues: 1},
+		{name: "untrusted input with %q — ok", verb: "%q", input: "a request parameter", wantIssues: 0},
+		{name: "untrusted input with %x — ok", verb: "%x", input: "a request parameter", wantIssues: 0},
+		{name: "untrusted input with %#v — ok", verb: "%#v", input: "a request parameter", wantIssues: 0},
+		{name: "trusted value with %s — ok", verb: "%s", input: "", wantIssues: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts := makeParts("user "+tt.verb+" logged in", true, "name", false)
+			parts[0].Verbs = []log.FormatVerb{{Verb: tt.verb, Offset: 5, Arg: "name", Pos: parts[1].Pos}}
+			parts[1].Input = tt.input
+			issues := f.Apply(makeCtx(parts))
+			if len(issues) != tt.wantIssues {
+				t.Errorf("got %d issues, want %d: %v", len(issues), tt.wantIssues, issues)
+			}
+		})
+	}
+}
+
+func TestLogInjectionFilter_Concatenation(t *testing.T) {
+	f := &LogInjectionFilter{}
+	parts := makeParts("user ", true, "name", false)
+	parts[1].Input = "the User-Agent header"
+
+	issues := f.Apply(makeCtx(parts))
+	if len(issues) != 1 {
+		t.Fatalf("got %d issues, want 1", len(issues))
+	}
+	if !strings.Contains(issues[0].Message, "the User-Agent header") {
+		t.Errorf("message does not name the input: %s", issues[0].Message)
+	}
+	if issues[0].Fix != nil {
+		t.Errorf("expected no fix without a format verb, got %+v", issues[0].Fix)
+	}
+}
+
+func TestLogInjectionFilter_SensitivePartReportedOnce(t *testing.T) {
+	parts := makeParts("session ", true, "session.Value", false)
+	parts[1].Input = "a cookie value"
+	parts[1].Origin = "a cookie value"
+
+	if issues := (&LogInjectionFilter{}).Apply(makeCtx(parts)); len(issues) != 1 {
+		t.Errorf("got %d issues without Security, want 1", len(issues))
+	}
+	if issues := (&LogInjectionFilter{Security: &SecurityFilter{}}).Apply(makeCtx(parts)); len(issues) != 0 {
+		t.Errorf("expected the part reported by Security to be skipped, got %v", issues)
+	}
+}
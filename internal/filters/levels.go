@@ -0,0 +1,22 @@
+package filters
+
+import (
+	"slices"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+)
+
+// LevelFilter applies Filter to the log calls whose level is not one of
+// SkipLevels, e.g. to leave the wording of debug messages unchecked. Calls
+// whose level is unknown are always checked.
+type LevelFilter struct {
+	Filter     LogFilter
+	SkipLevels []string
+}
+
+func (f *LevelFilter) Apply(context *log.LogContext) []FilterIssue {
+	if context.Level != "" && slices.Contains(f.SkipLevels, context.Level) {
+		return nil
+	}
+	return f.Filter.Apply(context)
+}
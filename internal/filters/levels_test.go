@@ -0,0 +1,27 @@
+package filters
+
+import "testing"
+
+func TestLevelFilter(t *testing.T) {
+	f := &LevelFilter{Filter: &FirstLetterFilter{}, SkipLevels: []string{"debug", "trace"}}
+
+	tests := []struct {
+		name       string
+		level      string
+		wantIssues int
+	}{
+		{name: "skipped level — ok", level: "debug", wantIssues: 0},
+		{name: "other level — issue", level: "info", wantIssues: 1},
+		{name: "unknown level — issue", level: "", wantIssues: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := makeCtx(makeParts("Starting server", true))
+			ctx.Level = tt.level
+			if issues := f.Apply(ctx); len(issues) != tt.wantIssues {
+				t.Errorf("got %d issues, want %d", len(issues), tt.wantIssues)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package filters
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+)
+
+// PIIFilter reports log messages that may expose personal data, separately
+// from the credentials SecurityFilter looks for.
+//
+// Literals are scanned for email addresses, phone numbers, IP addresses,
+// card numbers passing the Luhn check, IBANs passing their mod-97 check and
+// passport-like document numbers; Patterns disables detectors by name. IP
+// addresses, ungrouped card numbers and document numbers are only reported
+// after a label, such as "client" or "passport".
+// Non-literal values are checked by name against the PII keywords (email,
+// ssn, dob, phone, address, …), as is the label of a printf verb
+// ("email: %s"). ExtraKeywords extends the keyword list and IgnoreKeywords
+// removes entries from it. A value wrapped in one of Sanitizers, or in a
+// built-in sanitizer, is not reported.
+type PIIFilter struct {
+	ExtraKeywords  []string
+	IgnoreKeywords []string
+	Patterns       map[string]bool
+	Sanitizers     []string
+}
+
+var piiKeywords = []string{
+	"email",
+	"ssn",
+	"dob",
+	"phone",
+	"address",
+	"passport",
+	"iban",
+}
+
+// piiPattern is a detector of personal data in literal text. Valid, when
+// set, validates a match of re, e.g. with a checksum.
+type piiPattern struct {
+	// Name identifies the detector in the pii.patterns configuration.
+	Name string
+	// Desc describes what the detector finds, e.g. "an email address".
+	Desc  string
+	re    *regexp.Regexp
+	Valid func(match string) bool
+	// Labels, when set, are the words one of which must come right before a
+	// match, in the literal or the key it is logged under, as in
+	// "client 203.0.113.7": version strings and numeric codes look alike.
+	Labels []string
+}
+
+// ipLabels name a network peer whose address may be logged.
+var ipLabels = []string{"ip", "addr", "address", "remote", "client", "host", "peer", "from", "src", "source", "dst", "destination", "origin", "forwarded"}
+
+var piiPatterns = []piiPattern{
+	{Name: "email", Desc: "an email address", re: regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`)},
+	{Name: "phone", Desc: "a phone number", re: regexp.MustCompile(`(?:\+\d{1,3}[ .-]?)?(?:\(\d{3}\)|\b\d{3})[ .-]\d{3}[ .-]\d{4}\b|\+\d{10,14}\b`)},
+	{Name: "ip_address", Desc: "an IP address", re: regexp.MustCompile(`[0-9A-Fa-f]*[.:][0-9A-Fa-f.:]+`), Valid: identifyingIP, Labels: ipLabels},
+	// Card numbers grouped in fours stand out on their own; an ungrouped run
+	// of digits, such as a nanosecond timestamp, only next to a card label.
+	{Name: "credit_card", Desc: "a card number", re: regexp.MustCompile(`\b\d{4}(?:[ -]\d{2,6}){2,4}\b`), Valid: cardNumber},
+	{Name: "credit_card", Desc: "a card number", re: regexp.MustCompile(`\b\d{13,19}\b`), Valid: cardNumber, Labels: []string{"card", "pan", "cc"}},
+	{Name: "iban", Desc: "an IBAN", re: regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`), Valid: ibanValid},
+	{Name: "passport", Desc: "a passport-like document number", re: regexp.MustCompile(`\b[A-Z]{1,2}\d{6,9}\b`), Labels: []string{"passport", "document", "doc"}},
+}
+
+// allKeywords returns the PII keywords: the built-in ones not ignored and the
+// extra ones, all lowercase.
+func (f *PIIFilter) allKeywords() []string {
+	var all []string
+	for _, kw := range piiKeywords {
+		if !slices.ContainsFunc(f.IgnoreKeywords, func(ignored string) bool { return strings.EqualFold(ignored, kw) }) {
+			all = append(all, kw)
+		}
+	}
+	for _, kw := range f.ExtraKeywords {
+		all = append(all, strings.ToLower(kw))
+	}
+	return all
+}
+
+// patternEnabled reports whether the detector name is enabled: all are
+// unless Patterns sets them to false.
+func (f *PIIFilter) patternEnabled(name string) bool {
+	enabled, ok := f.Patterns[name]
+	return !ok || enabled
+}
+
+func (f *PIIFilter) Apply(context *log.LogContext) []FilterIssue {
+	keywords := f.allKeywords()
+	sanitizers := &SecurityFilter{Sanitizers: f.Sanitizers}
+	var issues []FilterIssue
+	// labelled holds the arguments already reported through their label.
+	var labelled argSpans
+	for i, part := range context.Parts {
+		if part.IsLiteral {
+			issues = append(issues, f.patternIssues(context, i)...)
+			for _, issue := range f.labelIssues(context, part, keywords, sanitizers) {
+				issues = append(issues, issue)
+				labelled.add(context, issue.Pos)
+			}
+			// A literal resolved from a constant or variable is also checked by name.
+			if part.Name != "" {
+				issues = append(issues, nameIssues(part.Name, part, keywords)...)
+			}
+			continue
+		}
+		if labelled.contains(part.Pos) || (part.Expr != nil && context.Pass != nil && sanitizers.SanitizerCall(context.Pass.TypesInfo, part.Expr)) {
+			continue
+		}
+		issues = append(issues, nameIssues(sanitizers.exposedName(context, part), part, keywords)...)
+	}
+	return issues
+}
+
+// nameIssues reports part when name, the source it is named after, matches a
+// PII keyword.
+func nameIssues(name string, part log.LogPart, keywords []string) []FilterIssue {
+	kw, ok := containsSensitiveKeyword(name, keywords)
+	if !ok {
+		return nil
+	}
+	variable := part.Value
+	if part.IsLiteral {
+		variable = part.Name
+	}
+	return []FilterIssue{{
+		Message: fmt.Sprintf("log message may expose personal data: variable %q matches keyword %q", variable, kw),
+		Pos:     part.Pos,
+	}}
+}
+
+// patternIssues reports the kinds of personal data found in the literal part
+// at index i of the context, each once.
+func (f *PIIFilter) patternIssues(context *log.LogContext, i int) []FilterIssue {
+	part := context.Parts[i]
+	var issues []FilterIssue
+	reported := map[string]bool{}
+	for _, p := range piiPatterns {
+		if !f.patternEnabled(p.Name) || reported[p.Name] {
+			continue
+		}
+		for _, loc := range p.re.FindAllStringIndex(part.Value, -1) {
+			match := part.Value[loc[0]:loc[1]]
+			if (p.Valid == nil || p.Valid(match)) && labelledMatch(p.Labels, precedingText(context, i, loc[0])) {
+				issues = append(issues, FilterIssue{
+					Message: fmt.Sprintf("log message may expose personal data: literal contains %s", p.Desc),
+					Pos:     part.Pos,
+				})
+				reported[p.Name] = true
+				break
+			}
+		}
+	}
+	return issues
+}
+
+// precedingText returns the text of the context before offset in its part at
+// index i, including the parts before it, such as the key of a key/value pair.
+func precedingText(context *log.LogContext, i, offset int) string {
+	var sb strings.Builder
+	for _, part := range context.Parts[:i] {
+		sb.WriteString(part.Value)
+		sb.WriteByte(' ')
+	}
+	sb.WriteString(context.Parts[i].Value[:offset])
+	return sb.String()
+}
+
+// labelledMatch reports whether one of the last two words of before is among
+// labels, or labels is empty.
+func labelledMatch(labels []string, before string) bool {
+	if len(labels) == 0 {
+		return true
+	}
+	words := splitWords(before)
+	for _, w := range words[max(0, len(words)-2):] {
+		if slices.Contains(labels, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// labelIssues reports the arguments of a format string part whose verb is
+// labelled with a PII keyword, as in "email: %s".
+func (f *PIIFilter) labelIssues(context *log.LogContext, part log.LogPart, keywords []string, sanitizers *SecurityFilter) []FilterIssue {
+	var issues []FilterIssue
+	prev := 0
+	for _, verb := range part.Verbs {
+		label := part.Value[prev:verb.Offset]
+		prev = verb.Offset + len(verb.Verb)
+		kw, ok := labelKeyword(label, keywords)
+		if !ok || !verb.Pos.IsValid() || sanitizers.sanitizedArg(context, verb.Pos) {
+			continue
+		}
+		issues = append(issues, FilterIssue{
+			Message: fmt.Sprintf("log message may expose personal data: argument %q is labelled %q", verb.Arg, kw),
+			Pos:     verb.Pos,
+		})
+	}
+	return issues
+}
+
+// identifyingIP reports whether s is an IPv4 or IPv6 address, possibly
+// followed by a port, other than a loopback, unspecified or link-local one,
+// which identify no one.
+func identifyingIP(s string) bool {
+	s = strings.TrimRight(s, ".:")
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		// 203.0.113.7:443; the brackets of [2001:db8::1]:443 end the match
+		// before the port.
+		addrPort, err := netip.ParseAddrPort(s)
+		if err != nil {
+			return false
+		}
+		addr = addrPort.Addr()
+	}
+	if addr.Is6() && strings.Count(s, ":") < 2 {
+		return false
+	}
+	return !addr.IsLoopback() && !addr.IsUnspecified() && !addr.IsLinkLocalUnicast()
+}
+
+// luhnValid reports whether the digits of s, a number of 13 to 19 digits
+// optionally grouped with spaces or dashes, pass the Luhn check.
+func luhnValid(s string) bool {
+	sum, n := 0, 0
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] < '0' || s[i] > '9' {
+			continue
+		}
+		d := int(s[i] - '0')
+		if n%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n >= 13 && n <= 19 && sum%10 == 0
+}
+
+// cardNumber reports whether s, a number of 13 to 19 digits optionally
+// grouped with spaces or dashes, starts with the issuer prefix of a major card
+// scheme (Visa, Mastercard, American Express, Discover, JCB, UnionPay) and
+// passes the Luhn check.
+func cardNumber(s string) bool {
+	digits := strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, s)
+	if len(digits) < 6 {
+		return false
+	}
+	prefix, _ := strconv.Atoi(digits[:6])
+	switch {
+	case digits[0] == '4',
+		prefix >= 510000 && prefix < 560000, prefix >= 222100 && prefix < 272100,
+		prefix/10000 == 34, prefix/10000 == 37,
+		prefix/100 == 6011, prefix/10000 == 65, prefix >= 644000 && prefix < 650000,
+		prefix/100 >= 3528 && prefix/100 <= 3589,
+		prefix/10000 == 62:
+		return luhnValid(s)
+	}
+	return false
+}
+
+// ibanValid reports whether s, an IBAN optionally grouped with spaces, passes
+// the ISO 13616 mod-97 check.
+func ibanValid(s string) bool {
+	s = strings.ReplaceAll(s, " ", "")
+	if len(s) < 15 || len(s) > 34 {
+		return false
+	}
+	var digits strings.Builder
+	for _, r := range s[4:] + s[:4] {
+		switch {
+		case unicode.IsDigit(r):
+			digits.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			fmt.Fprintf(&digits, "%d", r-'A'+10)
+		default:
+			return false
+		}
+	}
+	n, ok := new(big.Int).SetString(digits.String(), 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
@@ -0,0 +1,144 @@
+package filters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+)
+
+func TestPIIFilter_Literals(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   *PIIFilter
+		value    string
+		wantDesc string
+	}{
+		{name: "email", filter: &PIIFilter{}, value: "sent to jane.doe@example.com", wantDesc: "an email address"},
+		{name: "phone", filter: &PIIFilter{}, value: "call (415) 555-2671", wantDesc: "a phone number"},
+		{name: "international phone", filter: &PIIFilter{}, value: "call +14155552671", wantDesc: "a phone number"},
+		{name: "ipv4", filter: &PIIFilter{}, value: "client 203.0.113.7 connected", wantDesc: "an IP address"},
+		{name: "ipv6", filter: &PIIFilter{}, value: "client 2001:db8::8a2e:370:7334 connected", wantDesc: "an IP address"},
+		{name: "ipv4 with port", filter: &PIIFilter{}, value: "remote 1.2.3.4:443 closed", wantDesc: "an IP address"},
+		{name: "ipv6 with port", filter: &PIIFilter{}, value: "remote [2001:db8::1]:443 closed", wantDesc: "an IP address"},
+		{name: "card number", filter: &PIIFilter{}, value: "card 4111 1111 1111 1111", wantDesc: "a card number"},
+		{name: "iban", filter: &PIIFilter{}, value: "paying to DE89 3704 0044 0532 0130 00", wantDesc: "an IBAN"},
+		{name: "passport", filter: &PIIFilter{}, value: "document AB1234567", wantDesc: "a passport-like document number"},
+		{name: "disabled detector", filter: &PIIFilter{Patterns: map[string]bool{"email": false}}, value: "sent to jane.doe@example.com"},
+		{name: "card number failing luhn", filter: &PIIFilter{}, value: "order 4111 1111 1111 1112"},
+		{name: "iban failing checksum", filter: &PIIFilter{}, value: "paying to DE89 3704 0044 0532 0130 02"},
+		{name: "loopback address", filter: &PIIFilter{}, value: "listening on 127.0.0.1 and ::1"},
+		{name: "unspecified address", filter: &PIIFilter{}, value: "listening on 0.0.0.0"},
+		{name: "loopback address with port", filter: &PIIFilter{}, value: "listening on 127.0.0.1:8080"},
+		{name: "time of day", filter: &PIIFilter{}, value: "started at 12:30:45"},
+		{name: "date", filter: &PIIFilter{}, value: "released on 2024-01-31"},
+		{name: "plain text", filter: &PIIFilter{}, value: "server started on port 8080"},
+		{name: "labelled card number", filter: &PIIFilter{}, value: "card 4111111111111111 declined", wantDesc: "a card number"},
+		{name: "labelled passport", filter: &PIIFilter{}, value: "passport AB1234567 expired", wantDesc: "a passport-like document number"},
+		{name: "version string", filter: &PIIFilter{}, value: "upgraded to 1.2.3.4"},
+		{name: "nine-digit error code", filter: &PIIFilter{}, value: "request failed with error 123456789"},
+		{name: "prefixed error code", filter: &PIIFilter{}, value: "request failed with code E12345678"},
+		{name: "luhn-valid nanosecond timestamp", filter: &PIIFilter{}, value: "event at 1700000000000000004 processed"},
+		{name: "ungrouped number without issuer prefix", filter: &PIIFilter{}, value: "card 1700000000000000004"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := tt.filter.Apply(makeCtx(makeParts(tt.value, true)))
+			if tt.wantDesc == "" {
+				if len(issues) != 0 {
+					t.Errorf("expected no issues, got %v", issues)
+				}
+				return
+			}
+			if len(issues) != 1 || !strings.Contains(issues[0].Message, tt.wantDesc) {
+				t.Errorf("expected one issue mentioning %q, got %v", tt.wantDesc, issues)
+			}
+		})
+	}
+}
+
+func TestPIIFilter_Identifiers(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     *PIIFilter
+		value      string
+		wantIssues int
+	}{
+		{name: "email", filter: &PIIFilter{}, value: "userEmail", wantIssues: 1},
+		{name: "ssn", filter: &PIIFilter{}, value: "ssn", wantIssues: 1},
+		{name: "dob", filter: &PIIFilter{}, value: "u.DOB", wantIssues: 1},
+		{name: "phone", filter: &PIIFilter{}, value: "phone_number", wantIssues: 1},
+		{name: "address", filter: &PIIFilter{}, value: "homeAddress", wantIssues: 1},
+		{name: "ignored keyword", filter: &PIIFilter{IgnoreKeywords: []string{"Address"}}, value: "listenAddress", wantIssues: 0},
+		{name: "extra keyword", filter: &PIIFilter{ExtraKeywords: []string{"Surname"}}, value: "surname", wantIssues: 1},
+		{name: "unrelated name", filter: &PIIFilter{}, value: "userID", wantIssues: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := tt.filter.Apply(makeCtx(makeParts(tt.value, false)))
+			if len(issues) != tt.wantIssues {
+				t.Errorf("got %d issues, want %d: %v", len(issues), tt.wantIssues, issues)
+			}
+		})
+	}
+}
+
+func TestPIIFilter_ResolvedLiteral(t *testing.T) {
+	parts := makeParts("jane", true)
+	parts[0].Name = "customerEmail"
+
+	issues := (&PIIFilter{}).Apply(makeCtx(parts))
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, `variable "customerEmail" matches keyword "email"`) {
+		t.Errorf("expected the literal to be reported by name, got %v", issues)
+	}
+}
+
+func TestPIIFilter_Label(t *testing.T) {
+	f := &PIIFilter{}
+	parts := makeParts("contact email: %s", true, "c", false)
+	parts[0].Verbs = []log.FormatVerb{{Verb: "%s", Offset: 15, Arg: "c", Pos: parts[1].Pos}}
+
+	issues := f.Apply(makeCtx(parts))
+	if len(issues) != 1 || issues[0].Pos != parts[1].Pos || !strings.Contains(issues[0].Message, `argument "c" is labelled "email"`) {
+		t.Errorf("expected the labelled argument to be reported, got %v", issues)
+	}
+}
+
+func TestLuhnValid(t *testing.T) {
+	for s, want := range map[string]bool{
+		"4111111111111111":    true,
+		"5500-0000-0000-0004": true,
+		"4111111111111112":    false,
+		"411111111111":        false,
+	} {
+		if got := luhnValid(s); got != want {
+			t.Errorf("luhnValid(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestPIIFilter_KeyLabel(t *testing.T) {
+	issues := (&PIIFilter{}).Apply(makeCtx(makeParts("remote_addr", true, "198.51.100.23", true)))
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "an IP address") {
+		t.Errorf("expected the address logged under an address key to be reported, got %v", issues)
+	}
+	issues = (&PIIFilter{}).Apply(makeCtx(makeParts("version", true, "1.2.3.4", true)))
+	if len(issues) != 0 {
+		t.Errorf("expected a version logged under its key not to be reported, got %v", issues)
+	}
+}
+
+func TestIBANValid(t *testing.T) {
+	for s, want := range map[string]bool{
+		"GB82WEST12345698765432":      true,
+		"DE89 3704 0044 0532 0130 00": true,
+		"GB82WEST12345698765433":      false,
+		"GB82":                        false,
+	} {
+		if got := ibanValid(s); got != want {
+			t.Errorf("ibanValid(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package filters
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+	"github.com/PriestFaria/lingo/internal/redact"
+
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// redactFix returns a fix that declares a redacting logging method for t, a
+// struct type of the package under analysis with sensitive fields: LogValue
+// for slog and most loggers, MarshalLogObject when the call is made through
+// zap. It returns nil when t is declared elsewhere or already has the method.
+func (f *SecurityFilter) redactFix(context *log.LogContext, t types.Type) *IssueFix {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() != context.Pass.Pkg {
+		return nil
+	}
+
+	style := redact.Slog
+	if callee := typeutil.Callee(context.Pass.TypesInfo, context.CallExpr); callee != nil && callee.Pkg() != nil && callee.Pkg().Path() == "go.uber.org/zap" {
+		style = redact.Zap
+	}
+	if obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), false, named.Obj().Pkg(), style.MethodName()); obj != nil {
+		return nil
+	}
+
+	end := typeDeclEnd(context.Pass.Files, named.Obj())
+	if !end.IsValid() {
+		return nil
+	}
+	method, err := redact.Method(named, style, f.RedactedFields(context.Pass.Pkg))
+	if err != nil {
+		return nil
+	}
+	return &IssueFix{
+		Message: fmt.Sprintf("generate a redacting %s method for %s", style.MethodName(), named.Obj().Name()),
+		Pos:     end,
+		End:     end,
+		NewText: "\n\n" + strings.TrimSuffix(method, "\n"),
+		Imports: []string{style.Import()},
+	}
+}
+
+// RedactedFields returns the classifier of the fields a redacting logging
+// method of a struct declared in local leaves out: the fields marked
+// sensitive, named after a keyword, or holding a sensitive type. The redact
+// fix and gen-redact share it, so both generate the same method.
+func (f *SecurityFilter) RedactedFields(local *types.Package) func(*types.Var) bool {
+	catalogue := typeCatalogue(f.SensitiveTypes, f.IgnoreTypes)
+	keywords := f.allKeywords()
+	search := &typeSearch{keywords: keywords, local: local}
+	return func(v *types.Var) bool {
+		if (f.Marks != nil && f.Marks.Sensitive(v)) || search.keywordField(v, local) {
+			return true
+		}
+		_, _, ok := sensitiveType(v.Type(), catalogue, keywords, f.Marks, local)
+		return ok
+	}
+}
+
+// typeDeclEnd returns the end of the declaration of the type obj, or
+// token.NoPos when it is not a top-level declaration of files.
+func typeDeclEnd(files []*ast.File, obj *types.TypeName) token.Pos {
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				if ts := spec.(*ast.TypeSpec); ts.Name.Pos() == obj.Pos() {
+					return gen.End()
+				}
+			}
+		}
+	}
+	return token.NoPos
+}
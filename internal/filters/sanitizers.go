@@ -0,0 +1,89 @@
+package filters
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// defaultSanitizers are the functions whose result does not expose their
+// arguments: lengths and cryptographic hashes. Builtins are named plainly,
+// other functions as types.Func.FullName prints them.
+var defaultSanitizers = []string{
+	"len", "cap",
+	"crypto/sha256.Sum256", "crypto/sha256.Sum224",
+	"crypto/sha512.Sum512", "crypto/sha512.Sum384",
+	"golang.org/x/crypto/bcrypt.GenerateFromPassword",
+}
+
+// IsSanitizer reports whether the function with the given full name is one of
+// the built-in sanitizers or one of Sanitizers.
+func (f *SecurityFilter) IsSanitizer(name string) bool {
+	return slices.Contains(defaultSanitizers, name) || slices.Contains(f.Sanitizers, name)
+}
+
+// SanitizerCall reports whether expr is a call to a sanitizer, whose result is
+// safe to log whatever its arguments.
+func (f *SecurityFilter) SanitizerCall(info *types.Info, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	switch fn := typeutil.Callee(info, call).(type) {
+	case *types.Func:
+		return f.IsSanitizer(fn.Origin().FullName())
+	case *types.Builtin:
+		return f.IsSanitizer(fn.Name())
+	}
+	return false
+}
+
+// exposedName returns the text of a non-literal part that is matched against
+// the keywords: its source, or, when it wraps sanitizer calls, only the
+// identifiers and literals outside them.
+func (f *SecurityFilter) exposedName(context *log.LogContext, part log.LogPart) string {
+	if part.Expr == nil || context.Pass == nil {
+		return part.Value
+	}
+	info := context.Pass.TypesInfo
+	sanitized := false
+	var words []string
+	ast.Inspect(part.Expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if f.SanitizerCall(info, n) {
+				sanitized = true
+				return false
+			}
+		case *ast.Ident:
+			words = append(words, n.Name)
+		case *ast.BasicLit:
+			words = append(words, n.Value)
+		}
+		return true
+	})
+	if !sanitized {
+		return part.Value
+	}
+	return strings.Join(words, " ")
+}
+
+// sanitizedArg reports whether the operand formatted at pos is a call to a
+// sanitizer.
+func (f *SecurityFilter) sanitizedArg(context *log.LogContext, pos token.Pos) bool {
+	if context.Pass == nil || !pos.IsValid() {
+		return false
+	}
+	for _, part := range context.Parts {
+		if part.Pos == pos && part.Expr != nil && f.SanitizerCall(context.Pass.TypesInfo, part.Expr) {
+			return true
+		}
+	}
+	return false
+}
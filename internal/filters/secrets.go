@@ -0,0 +1,150 @@
+package filters
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
+)
+
+// secretPattern is an entry of the secret catalogue: a regular expression
+// matching a well-known kind of credential, after the gitleaks rules.
+type secretPattern struct {
+	// Name identifies the pattern in the secret_patterns configuration.
+	Name string
+	// Desc describes what the pattern matches, e.g. "an AWS access key ID".
+	Desc string
+	re   *regexp.Regexp
+}
+
+var secretPatterns = []secretPattern{
+	{"aws_access_key", "an AWS access key ID", regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA|A3T[A-Z0-9])[A-Z0-9]{16}\b`)},
+	{"github_token", "a GitHub token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,255}|github_pat_[A-Za-z0-9_]{82})\b`)},
+	{"slack_webhook", "a Slack webhook URL", regexp.MustCompile(`hooks\.slack\.com/(?:services|workflows|triggers)/[A-Za-z0-9+/]{43,56}`)},
+	{"slack_token", "a Slack token", regexp.MustCompile(`\bxox[baprs]-[A-Za-z0-9-]{10,48}\b`)},
+	{"jwt", "a JSON Web Token", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]*`)},
+	{"private_key", "a PEM private key", regexp.MustCompile(`-----BEGIN[ A-Z0-9_-]{0,100}PRIVATE KEY(?: BLOCK)?-----`)},
+}
+
+// highEntropy is the name of the entropy check in the secret_patterns
+// configuration.
+const highEntropy = "high_entropy"
+
+// DefaultEntropyThreshold is the Shannon entropy, in bits per character,
+// from which a long token of a literal is taken for a secret.
+const DefaultEntropyThreshold = 4.5
+
+// minEntropyTokenLen is the length from which a token is checked for entropy.
+// A token of n characters has at most log2(n) bits of entropy per character,
+// so at the default threshold only tokens of 23 characters or more can be
+// reported; shorter random tokens are too common in ordinary text anyway.
+const minEntropyTokenLen = 23
+
+// patternEnabled reports whether the secret pattern name is enabled: all are
+// unless SecretPatterns sets them to false.
+func (f *SecurityFilter) patternEnabled(name string) bool {
+	enabled, ok := f.SecretPatterns[name]
+	return !ok || enabled
+}
+
+// secretIssues reports a literal part holding text that looks like a secret:
+// a match of the secret catalogue or, failing that, a long token whose
+// entropy reaches the threshold.
+func (f *SecurityFilter) secretIssues(part log.LogPart) []FilterIssue {
+	for _, p := range secretPatterns {
+		if f.patternEnabled(p.Name) && p.re.MatchString(part.Value) {
+			return []FilterIssue{{
+				Message: fmt.Sprintf("log message may expose sensitive data: literal contains %s", p.Desc),
+				Pos:     part.Pos,
+			}}
+		}
+	}
+	if !f.patternEnabled(highEntropy) {
+		return nil
+	}
+	threshold := f.EntropyThreshold
+	if threshold == 0 {
+		threshold = DefaultEntropyThreshold
+	}
+	for _, token := range secretTokens(part.Value) {
+		if h := shannonEntropy(token); h >= threshold {
+			return []FilterIssue{{
+				Message: fmt.Sprintf("log message may expose sensitive data: literal contains a high-entropy string (%.1f bits per character)", h),
+				Pos:     part.Pos,
+			}}
+		}
+	}
+	return nil
+}
+
+// secretTokens splits s into the runs of characters that make up encoded
+// keys (letters, digits and the base64 and base64url symbols) and returns
+// those long enough to check that mix letters and digits. A run written as
+// key=value, or following "key: ", is checked without its key, and skipped
+// when the key names an identifier such as a request or trace ID, which is
+// random by design.
+func secretTokens(s string) []string {
+	var tokens []string
+	prevEnd, prev := 0, ""
+	for _, loc := range secretTokenRe.FindAllStringIndex(s, -1) {
+		token, key := s[loc[0]:loc[1]], ""
+		if strings.TrimSpace(s[prevEnd:loc[0]]) == ":" {
+			key = prev
+		}
+		prevEnd, prev = loc[1], token
+		// Trailing '=' is base64 padding, any other one separates a key.
+		if i := strings.IndexByte(token, '='); i > 0 && strings.TrimRight(token[i:], "=") != "" {
+			key, token = token[:i], strings.TrimLeft(token[i:], "=")
+		}
+		if identifierKey(key) {
+			continue
+		}
+		if len(token) >= minEntropyTokenLen &&
+			strings.IndexFunc(token, unicode.IsLetter) >= 0 && strings.IndexFunc(token, unicode.IsDigit) >= 0 {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// secretTokenRe matches the runs of characters secretTokens splits a literal
+// into.
+var secretTokenRe = regexp.MustCompile(`[A-Za-z0-9+/=_-]+`)
+
+// identifierWords are the words naming the keys of values that look random
+// but are identifiers, not secrets.
+var identifierWords = map[string]bool{
+	"id": true, "ids": true, "uuid": true, "guid": true,
+	"request": true, "req": true, "trace": true, "traceparent": true,
+	"span": true, "correlation": true,
+}
+
+// identifierKey reports whether key, as in request_id or X-Trace-Id, names
+// an identifier.
+func identifierKey(key string) bool {
+	for _, word := range splitWords(key) {
+		if identifierWords[word] {
+			return true
+		}
+	}
+	return false
+}
+
+// shannonEntropy returns the Shannon entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	counts := map[rune]int{}
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+	var h float64
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		h -= p * math.Log2(p)
+	}
+	return h
+}
@@ -2,6 +2,9 @@ package filters
 
 import (
 	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
 	"strings"
 	"unicode"
 
@@ -12,8 +15,35 @@ import (
 // It checks both string literals (for marker words such as "password:") and
 // variable names (for identifiers like passwordHash or auth_token).
 // ExtraKeywords extends the built-in sensitive keyword list.
+//
+// In a format string whose verbs are paired with their arguments, a keyword
+// labelling a verb ("token: %s") is reported at the argument it formats. A
+// keyword only mentioned in prose ("token expired after %d retries") is a low
+// confidence finding, reported when LowConfidence is set.
+//
+// Values are also checked by type against a catalogue of sensitive types
+// (*http.Request, http.Header, *rsa.PrivateKey, …), including when such a type
+// is nested in a logged struct. SensitiveTypes extends the catalogue and
+// IgnoreTypes removes entries from it; both use "import/path.Name". Marks, when
+// set, adds the types and fields marked sensitive in the source code.
+//
+// A value wrapped in a sanitizer — len, a cryptographic hash or one of
+// Sanitizers, given as fully-qualified function names — is not reported.
+//
+// Literals are also scanned for secrets: matches of a catalogue of credential
+// formats (AWS access keys, GitHub and Slack tokens, JWTs, PEM private keys)
+// and long tokens whose Shannon entropy reaches EntropyThreshold, or
+// DefaultEntropyThreshold when it is zero. SecretPatterns disables entries of
+// the catalogue, and the entropy check as "high_entropy", by name.
 type SecurityFilter struct {
-	ExtraKeywords []string
+	ExtraKeywords    []string
+	LowConfidence    bool
+	SensitiveTypes   []string
+	IgnoreTypes      []string
+	Sanitizers       []string
+	Marks            SensitiveMarks
+	SecretPatterns   map[string]bool
+	EntropyThreshold float64
 }
 
 // allKeywords returns the merged list of built-in and extra sensitive keywords,
@@ -43,12 +73,14 @@ var sensitiveKeywords = []string{
 }
 
 // splitWords splits a camelCase or snake_case identifier into lowercase words.
+// Any other non-alphanumeric character also separates words, so expressions
+// such as cfg.Password or u.GetSecret() are split at dots and parentheses.
 func splitWords(s string) []string {
 	var words []string
 	var cur strings.Builder
 	runes := []rune(s)
 	for i, r := range runes {
-		if r == '_' || r == '-' {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
 			if cur.Len() > 0 {
 				words = append(words, strings.ToLower(cur.String()))
 				cur.Reset()
@@ -67,6 +99,19 @@ func splitWords(s string) []string {
 	return words
 }
 
+// MatchName reports the sensitive keyword that an identifier such as a field
+// name matches, if any.
+func (f *SecurityFilter) MatchName(name string) (string, bool) {
+	return containsSensitiveKeyword(name, f.allKeywords())
+}
+
+// MatchType reports the catalogue type that t is or holds in a field, if
+// any, honouring SensitiveTypes and IgnoreTypes.
+func (f *SecurityFilter) MatchType(t types.Type) (string, bool) {
+	name, _, ok := sensitiveType(t, typeCatalogue(f.SensitiveTypes, f.IgnoreTypes), nil, nil, nil)
+	return name, ok
+}
+
 // containsSensitiveKeyword reports whether any word extracted from name (via
 // splitWords) exactly matches a keyword. Used for variable name checks.
 func containsSensitiveKeyword(name string, keywords []string) (string, bool) {
@@ -101,22 +146,180 @@ func containsSensitiveKeywordInLiteral(value string, keywords []string) (string,
 func (f *SecurityFilter) Apply(context *log.LogContext) []FilterIssue {
 	keywords := f.allKeywords()
 	var issues []FilterIssue
+	// labelled holds the arguments already reported through their label.
+	var labelled argSpans
 	for _, part := range context.Parts {
 		if part.IsLiteral {
-			if kw, ok := containsSensitiveKeywordInLiteral(part.Value, keywords); ok {
+			if len(part.Verbs) > 0 {
+				for _, issue := range f.formatIssues(context, part, keywords) {
+					issues = append(issues, issue)
+					labelled.add(context, issue.Pos)
+				}
+			} else if kw, ok := containsSensitiveKeywordInLiteral(part.Value, keywords); ok {
 				issues = append(issues, FilterIssue{
 					Message: fmt.Sprintf("log message may expose sensitive data: literal contains %q", kw),
 					Pos:     part.Pos,
 				})
 			}
+			issues = append(issues, f.secretIssues(part)...)
+			// A literal resolved from a constant or variable is also checked by name.
+			if part.Name == "" {
+				continue
+			}
+			if kw, ok := containsSensitiveKeyword(part.Name, keywords); ok {
+				issues = append(issues, FilterIssue{
+					Message: fmt.Sprintf("log message may expose sensitive data: variable %q matches keyword %q", part.Name, kw),
+					Pos:     part.Pos,
+				})
+			}
 		} else {
-			if kw, ok := containsSensitiveKeyword(part.Value, keywords); ok {
+			if part.Expr != nil && context.Pass != nil && f.SanitizerCall(context.Pass.TypesInfo, part.Expr) {
+				continue
+			}
+			if kw, ok := containsSensitiveKeyword(f.exposedName(context, part), keywords); ok && !labelled.contains(part.Pos) {
 				issues = append(issues, FilterIssue{
 					Message: fmt.Sprintf("log message may expose sensitive data: variable %q matches keyword %q", part.Value, kw),
 					Pos:     part.Pos,
 				})
 			}
+			issues = append(issues, f.typeIssues(context, part)...)
 		}
 	}
 	return issues
-}
\ No newline at end of file
+}
+
+// typeIssues checks a non-literal part by the sensitive value it is known to
+// hold or, failing that, by its type.
+func (f *SecurityFilter) typeIssues(context *log.LogContext, part log.LogPart) []FilterIssue {
+	var issues []FilterIssue
+	if part.Origin != "" {
+		return append(issues, FilterIssue{
+			Message: fmt.Sprintf("log message may expose sensitive data: %q holds %s", part.Value, part.Origin),
+			Pos:     part.Pos,
+		})
+	}
+	if part.Expr == nil || context.Pass == nil {
+		return issues
+	}
+	if sel, ok := part.Expr.(*ast.SelectorExpr); ok && f.Marks != nil {
+		if field, ok := context.Pass.TypesInfo.Uses[sel.Sel].(*types.Var); ok && field.IsField() && f.Marks.Sensitive(field) {
+			return append(issues, FilterIssue{
+				Message: fmt.Sprintf("log message may expose sensitive data: %q is a sensitive field", part.Value),
+				Pos:     part.Pos,
+			})
+		}
+	}
+	t := context.Pass.TypesInfo.TypeOf(part.Expr)
+	if t == nil {
+		return issues
+	}
+	catalogue := typeCatalogue(f.SensitiveTypes, f.IgnoreTypes)
+	keywords := f.allKeywords()
+	name, field, ok := sensitiveType(t, catalogue, keywords, f.Marks, context.Pass.Pkg)
+	if !ok {
+		return issues
+	}
+	var message string
+	switch {
+	case field == "":
+		message = fmt.Sprintf("log message may expose sensitive data: %q has sensitive type %s", part.Value, name)
+	case name == "":
+		message = fmt.Sprintf("log message may expose sensitive data: %q contains sensitive field %s", part.Value, field)
+	default:
+		message = fmt.Sprintf("log message may expose sensitive data: %q contains %s in field %s", part.Value, name, field)
+	}
+	issue := FilterIssue{Message: message, Pos: part.Pos}
+	if field != "" && !strings.Contains(field, ".") {
+		issue.Fix = f.redactFix(context, t)
+	}
+	return append(issues, issue)
+}
+
+// argSpans holds the source ranges of arguments already reported, so the
+// parts inside them, such as tok in (tok) or prefix+tok, are not reported
+// again.
+type argSpans []struct{ pos, end token.Pos }
+
+// add records the argument at pos: the whole operand a format verb of context
+// pairs with it, or pos alone.
+func (s *argSpans) add(context *log.LogContext, pos token.Pos) {
+	end := pos + 1
+	for _, part := range context.Parts {
+		for _, verb := range part.Verbs {
+			if verb.Pos == pos && verb.End.IsValid() {
+				end = verb.End
+			}
+		}
+	}
+	*s = append(*s, struct{ pos, end token.Pos }{pos, end})
+}
+
+// contains reports whether pos lies within one of the recorded arguments.
+func (s argSpans) contains(pos token.Pos) bool {
+	for _, span := range s {
+		if span.pos <= pos && pos < span.end {
+			return true
+		}
+	}
+	return false
+}
+
+// formatIssues checks a format string part whose verbs are paired with their
+// arguments: a keyword labelling a verb marks its argument as a sensitive
+// value, any other keyword is reported with low confidence.
+func (f *SecurityFilter) formatIssues(context *log.LogContext, part log.LogPart, keywords []string) []FilterIssue {
+	var issues []FilterIssue
+	labelled := false
+	prev := 0
+	for _, verb := range part.Verbs {
+		label := part.Value[prev:verb.Offset]
+		prev = verb.Offset + len(verb.Verb)
+		kw, ok := labelKeyword(label, keywords)
+		if !ok {
+			continue
+		}
+		labelled = true
+		if f.sanitizedArg(context, verb.Pos) {
+			continue
+		}
+		arg, pos := verb.Arg, verb.Pos
+		if arg == "" {
+			arg = verb.Verb
+		}
+		if !pos.IsValid() {
+			pos = part.Pos
+		}
+		issues = append(issues, FilterIssue{
+			Message: fmt.Sprintf("log message may expose sensitive data: argument %q is labelled %q", arg, kw),
+			Pos:     pos,
+		})
+	}
+	if labelled || !f.LowConfidence {
+		return issues
+	}
+	if kw, ok := containsSensitiveKeywordInLiteral(part.Value, keywords); ok {
+		issues = append(issues, FilterIssue{
+			Message: fmt.Sprintf("log message may expose sensitive data (low confidence): format string mentions %q", kw),
+			Pos:     part.Pos,
+		})
+	}
+	return issues
+}
+
+// labelKeyword reports whether the format text preceding a verb ends with a
+// keyword, as in "token: ", "api_key=" or "user token ".
+func labelKeyword(text string, keywords []string) (string, bool) {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return r == ' ' || r == ':' || r == '=' || r == '_' || r == '-' || r == '/'
+	})
+	if len(words) == 0 {
+		return "", false
+	}
+	last := words[len(words)-1]
+	for _, kw := range keywords {
+		if last == kw {
+			return kw, true
+		}
+	}
+	return "", false
+}
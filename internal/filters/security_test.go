@@ -1,7 +1,10 @@
 package filters
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/PriestFaria/lingo/internal/analyzer/log"
 )
 
 func TestSecurityFilter(t *testing.T) {
@@ -197,3 +200,95 @@ func TestSecurityFilter_MultipleVariables(t *testing.T) {
 		t.Errorf("got %d issues, want 3", len(issues))
 	}
 }
+
+func TestSecurityFilter_ResolvedPart_ChecksName(t *testing.T) {
+	f := &SecurityFilter{}
+	parts := []log.LogPart{{Value: "abc123", IsLiteral: true, Name: "apiSecret", Pos: 100, End: 109}}
+	issues := f.Apply(makeCtx(parts))
+	if len(issues) != 1 {
+		t.Errorf("got %d issues, want 1 for a resolved constant named apiSecret", len(issues))
+	}
+}
+
+func TestSecurityFilter_SplitWords_SelectorAndCall(t *testing.T) {
+	f := &SecurityFilter{}
+	for _, expr := range []string{"cfg.Password", "u.GetSecret()", `headers["X-Api-Key"]`} {
+		ctx := makeCtx(makeParts(expr, false))
+		if issues := f.Apply(ctx); len(issues) != 1 {
+			t.Errorf("got %d issues, want 1 for %q", len(issues), expr)
+		}
+	}
+}
+
+func TestSecurityFilter_SplitWords_SelectorNoFalsePositive(t *testing.T) {
+	f := &SecurityFilter{}
+	ctx := makeCtx(makeParts("cfg.Host", false))
+	if issues := f.Apply(ctx); len(issues) != 0 {
+		t.Errorf("got %d issues, want 0 for 'cfg.Host'", len(issues))
+	}
+}
+
+func TestSecurityFilter_FormatVerb_LabelledArgument(t *testing.T) {
+	f := &SecurityFilter{}
+	parts := makeParts("token: %s", true, "t", false)
+	parts[0].Verbs = []log.FormatVerb{{Verb: "%s", Offset: 7, Arg: "t", Pos: parts[1].Pos}}
+	issues := f.Apply(makeCtx(parts))
+	if len(issues) != 1 {
+		t.Fatalf("got %d issues, want 1", len(issues))
+	}
+	if issues[0].Pos != parts[1].Pos || !strings.Contains(issues[0].Message, `argument "t"`) {
+		t.Errorf("issue = %+v, want it at argument t", issues[0])
+	}
+}
+
+func TestSecurityFilter_FormatVerb_LabelledArgumentReportedOnce(t *testing.T) {
+	parts := makeParts("token: %s", true, "apiToken", false)
+	parts[0].Verbs = []log.FormatVerb{{Verb: "%s", Offset: 7, Arg: "apiToken", Pos: parts[1].Pos}}
+	issues := (&SecurityFilter{}).Apply(makeCtx(parts))
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "is labelled") {
+		t.Errorf("got %v, want only the labelled argument issue", issues)
+	}
+}
+
+func TestSecurityFilter_FormatVerb_WrappedLabelledArgumentReportedOnce(t *testing.T) {
+	// "token: %s", (apiToken): the operand starts at the parenthesis.
+	parts := makeParts("token: %s", true, "apiToken", false)
+	parts[0].Verbs = []log.FormatVerb{{Verb: "%s", Offset: 7, Arg: "(apiToken)", Pos: parts[1].Pos - 1, End: parts[1].End + 1}}
+	issues := (&SecurityFilter{}).Apply(makeCtx(parts))
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "is labelled") {
+		t.Errorf("parenthesised operand: got %v, want only the labelled argument issue", issues)
+	}
+
+	// "token: %s", prefix+apiToken: the operand spans both parts.
+	parts = makeParts("token: %s", true, "prefix", false, "apiToken", false)
+	parts[0].Verbs = []log.FormatVerb{{Verb: "%s", Offset: 7, Arg: "prefix + apiToken", Pos: parts[1].Pos, End: parts[2].End}}
+	issues = (&SecurityFilter{}).Apply(makeCtx(parts))
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "is labelled") {
+		t.Errorf("concatenated operand: got %v, want only the labelled argument issue", issues)
+	}
+}
+
+func TestSecurityFilter_FormatVerb_ProseIsLowConfidence(t *testing.T) {
+	parts := makeParts("token expired after %d retries", true, "n", false)
+	parts[0].Verbs = []log.FormatVerb{{Verb: "%d", Offset: 20, Arg: "n", Pos: parts[1].Pos}}
+
+	if issues := (&SecurityFilter{}).Apply(makeCtx(parts)); len(issues) != 0 {
+		t.Errorf("got %d issues, want 0 by default", len(issues))
+	}
+	issues := (&SecurityFilter{LowConfidence: true}).Apply(makeCtx(parts))
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "low confidence") {
+		t.Errorf("got %v, want one low confidence issue", issues)
+	}
+}
+
+func TestSecurityFilter_IsSanitizer(t *testing.T) {
+	f := &SecurityFilter{Sanitizers: []string{"example.com/redact.String"}}
+	for _, name := range []string{"len", "crypto/sha256.Sum256", "example.com/redact.String"} {
+		if !f.IsSanitizer(name) {
+			t.Errorf("%s should be a sanitizer", name)
+		}
+	}
+	if f.IsSanitizer("strings.ToUpper") {
+		t.Error("strings.ToUpper should not be a sanitizer")
+	}
+}
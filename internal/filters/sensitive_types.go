@@ -0,0 +1,146 @@
+package filters
+
+import (
+	"go/types"
+	"strings"
+)
+
+// sensitiveTypes is the built-in catalogue of types whose values carry
+// credentials or secrets when logged, written as "import/path.Name". Pointers
+// to these types match as well.
+var sensitiveTypes = []string{
+	"net/http.Request",
+	"net/http.Header",
+	"net/http.Cookie",
+	"net/url.Userinfo",
+	"crypto/tls.Config",
+	"crypto/tls.Certificate",
+	"crypto/rsa.PrivateKey",
+	"crypto/ecdsa.PrivateKey",
+	"crypto/ed25519.PrivateKey",
+	"crypto/ecdh.PrivateKey",
+}
+
+// typeCatalogue returns the sensitive type names in effect: the built-in
+// catalogue without ignored, plus extra.
+func typeCatalogue(extra, ignored []string) map[string]bool {
+	catalogue := make(map[string]bool, len(sensitiveTypes)+len(extra))
+	for _, name := range sensitiveTypes {
+		catalogue[name] = true
+	}
+	for _, name := range extra {
+		catalogue[strings.TrimPrefix(name, "*")] = true
+	}
+	for _, name := range ignored {
+		delete(catalogue, strings.TrimPrefix(name, "*"))
+	}
+	return catalogue
+}
+
+// SensitiveMarks reports what the source code marks as sensitive: named types
+// annotated //lingo:sensitive and struct fields tagged lingo:"secret", and
+// the types whose String, LogValue or MarshalLogObject method leaves such
+// fields out.
+type SensitiveMarks interface {
+	// Sensitive reports whether obj, a type name or a struct field, is marked.
+	Sensitive(obj types.Object) bool
+	// Redacts reports whether values of the named type log without their
+	// sensitive fields.
+	Redacts(obj *types.TypeName) bool
+}
+
+// sensitiveType reports the sensitive type t is, or holds in a field of a
+// (possibly nested) struct, together with the path of that field. A marked
+// field, or a field of a struct declared in local whose name matches one of
+// keywords, is reported with an empty name. marks and local may be nil.
+func sensitiveType(t types.Type, catalogue map[string]bool, keywords []string, marks SensitiveMarks, local *types.Package) (name, field string, ok bool) {
+	s := &typeSearch{catalogue: catalogue, keywords: keywords, marks: marks, local: local, seen: map[types.Type]bool{}}
+	return s.find(t, false)
+}
+
+// typeSearch walks a type and the fields of the structs it contains.
+type typeSearch struct {
+	catalogue map[string]bool
+	keywords  []string
+	marks     SensitiveMarks
+	local     *types.Package
+	seen      map[types.Type]bool
+}
+
+// keywordField reports whether f, a field of a struct declared in pkg, is
+// named after a keyword. Only the fields of local structs are matched by name:
+// elsewhere, names such as slog.Attr.Key say nothing about the data.
+func (s *typeSearch) keywordField(f *types.Var, pkg *types.Package) bool {
+	if pkg == nil || pkg != s.local {
+		return false
+	}
+	_, ok := containsSensitiveKeyword(f.Name(), s.keywords)
+	return ok
+}
+
+// find implements sensitiveType. Below a type that formats itself through a
+// String or Error method, %v prints the method's result rather than the
+// fields, so catalogue types are no longer looked for; marked fields still
+// are, unless the method redacts them.
+func (s *typeSearch) find(t types.Type, formatted bool) (string, string, bool) {
+	if s.seen[t] {
+		return "", "", false
+	}
+	s.seen[t] = true
+
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	var pkg *types.Package
+	if named, ok := t.(*types.Named); ok && named.Obj().Pkg() != nil {
+		obj := named.Obj()
+		pkg = obj.Pkg()
+		name := obj.Pkg().Path() + "." + obj.Name()
+		if !formatted && s.catalogue[name] {
+			return name, "", true
+		}
+		if s.marks != nil && s.marks.Redacts(obj) {
+			return "", "", false
+		}
+		if s.marks != nil && s.marks.Sensitive(obj) {
+			return name, "", true
+		}
+		formatted = formatted || formatsItself(named)
+	}
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return "", "", false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if s.marks != nil && s.marks.Sensitive(f) {
+			return "", f.Name(), true
+		}
+		if name, field, ok := s.find(f.Type(), formatted); ok {
+			if field != "" {
+				return name, f.Name() + "." + field, true
+			}
+			return name, f.Name(), true
+		}
+		if s.keywordField(f, pkg) {
+			return "", f.Name(), true
+		}
+	}
+	return "", "", false
+}
+
+// formatsItself reports whether values of t, or pointers to them, have a
+// String or Error method.
+func formatsItself(t *types.Named) bool {
+	for _, recv := range []types.Type{t, types.NewPointer(t)} {
+		mset := types.NewMethodSet(recv)
+		for _, name := range []string{"String", "Error"} {
+			if sel := mset.Lookup(nil, name); sel != nil {
+				if sig, ok := sel.Type().(*types.Signature); ok && sig.Params().Len() == 0 && sig.Results().Len() == 1 {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
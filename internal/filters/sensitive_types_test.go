@@ -0,0 +1,71 @@
+package filters
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+// namedType declares the named type pkgPath.name for tests.
+func namedType(pkgPath, name string, underlying types.Type) *types.Named {
+	pkg := types.NewPackage(pkgPath, pkgPath)
+	obj := types.NewTypeName(token.NoPos, pkg, name, nil)
+	return types.NewNamed(obj, underlying, nil)
+}
+
+func TestSensitiveType_Direct(t *testing.T) {
+	header := namedType("net/http", "Header", types.NewMap(types.Typ[types.String], types.Typ[types.String]))
+	name, field, ok := sensitiveType(types.NewPointer(header), typeCatalogue(nil, nil), nil, nil, nil)
+	if !ok || name != "net/http.Header" || field != "" {
+		t.Errorf("got (%q, %q, %v), want net/http.Header", name, field, ok)
+	}
+}
+
+func TestSensitiveType_NestedField(t *testing.T) {
+	header := namedType("net/http", "Header", types.NewMap(types.Typ[types.String], types.Typ[types.String]))
+	inner := types.NewStruct([]*types.Var{types.NewField(token.NoPos, nil, "Headers", header, false)}, nil)
+	outer := types.NewStruct([]*types.Var{types.NewField(token.NoPos, nil, "In", namedType("example.com/app", "Inner", inner), false)}, nil)
+
+	name, field, ok := sensitiveType(outer, typeCatalogue(nil, nil), nil, nil, nil)
+	if !ok || name != "net/http.Header" || field != "In.Headers" {
+		t.Errorf("got (%q, %q, %v), want net/http.Header in In.Headers", name, field, ok)
+	}
+}
+
+func TestSensitiveType_ExtraAndIgnored(t *testing.T) {
+	session := namedType("example.com/auth", "Session", types.NewStruct(nil, nil))
+	header := namedType("net/http", "Header", types.NewMap(types.Typ[types.String], types.Typ[types.String]))
+	catalogue := typeCatalogue([]string{"*example.com/auth.Session"}, []string{"net/http.Header"})
+
+	if _, _, ok := sensitiveType(session, catalogue, nil, nil, nil); !ok {
+		t.Error("extra type example.com/auth.Session should be sensitive")
+	}
+	if _, _, ok := sensitiveType(header, catalogue, nil, nil, nil); ok {
+		t.Error("ignored type net/http.Header should not be sensitive")
+	}
+}
+
+func TestSecurityFilter_Origin(t *testing.T) {
+	parts := makeParts("body", false)
+	parts[0].Origin = "an HTTP body read with io.ReadAll"
+	issues := (&SecurityFilter{}).Apply(makeCtx(parts))
+	if len(issues) != 1 {
+		t.Errorf("got %d issues, want 1 for a sensitive origin", len(issues))
+	}
+}
+
+func TestSensitiveType_KeywordField(t *testing.T) {
+	st := types.NewStruct([]*types.Var{
+		types.NewField(token.NoPos, nil, "Name", types.Typ[types.String], false),
+		types.NewField(token.NoPos, nil, "Password", types.Typ[types.String], false),
+	}, nil)
+	user := namedType("example.com/app", "User", st)
+
+	name, field, ok := sensitiveType(user, typeCatalogue(nil, nil), sensitiveKeywords, nil, user.Obj().Pkg())
+	if !ok || name != "" || field != "Password" {
+		t.Errorf("got (%q, %q, %v), want field Password", name, field, ok)
+	}
+	if _, _, ok := sensitiveType(user, typeCatalogue(nil, nil), sensitiveKeywords, nil, nil); ok {
+		t.Error("fields of a struct declared in another package should not be matched by name")
+	}
+}
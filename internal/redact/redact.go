@@ -0,0 +1,134 @@
+// Package redact generates logging methods that render a struct without its
+// sensitive fields: LogValue for log/slog and MarshalLogObject for zap.
+package redact
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Placeholder is logged in place of a sensitive field.
+const Placeholder = "[REDACTED]"
+
+// Style selects the generated method.
+type Style int
+
+const (
+	// Slog generates LogValue() slog.Value, implementing slog.LogValuer.
+	Slog Style = iota
+	// Zap generates MarshalLogObject(zapcore.ObjectEncoder) error,
+	// implementing zapcore.ObjectMarshaler.
+	Zap
+)
+
+// Import returns the import path the method of style refers to.
+func (s Style) Import() string {
+	if s == Zap {
+		return "go.uber.org/zap/zapcore"
+	}
+	return "log/slog"
+}
+
+// MethodName returns the name of the method generated in style.
+func (s Style) MethodName() string {
+	if s == Zap {
+		return "MarshalLogObject"
+	}
+	return "LogValue"
+}
+
+// Method returns the source of the style method for the named struct type,
+// logging every field under its name except those sensitive reports, which
+// are logged as Placeholder. Blank fields and locks are left out, and a struct
+// holding a lock gets a pointer receiver, so the method does not copy it.
+func Method(named *types.Named, style Style, sensitive func(*types.Var) bool) (string, error) {
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return "", fmt.Errorf("%s is not a struct type", named.Obj().Name())
+	}
+	if named.TypeParams().Len() > 0 {
+		return "", fmt.Errorf("%s is generic", named.Obj().Name())
+	}
+
+	name := named.Obj().Name()
+	recv := receiverName(name)
+	recvType := name
+	if holdsLock(st, map[types.Type]bool{}) {
+		recvType = "*" + name
+	}
+	var fields []*types.Var
+	for i := 0; i < st.NumFields(); i++ {
+		if f := st.Field(i); f.Name() != "_" && !holdsLock(f.Type(), map[types.Type]bool{}) {
+			fields = append(fields, f)
+		}
+	}
+	var b bytes.Buffer
+	switch style {
+	case Zap:
+		fmt.Fprintf(&b, "// MarshalLogObject implements zapcore.ObjectMarshaler. Sensitive fields are\n// logged as %q.\n", Placeholder)
+		fmt.Fprintf(&b, "func (%s %s) MarshalLogObject(enc zapcore.ObjectEncoder) error {\n", recv, recvType)
+		for _, f := range fields {
+			if sensitive(f) {
+				fmt.Fprintf(&b, "\tenc.AddString(%q, %q)\n", f.Name(), Placeholder)
+				continue
+			}
+			fmt.Fprintf(&b, "\tif err := enc.AddReflected(%q, %s.%s); err != nil {\n\t\treturn err\n\t}\n", f.Name(), recv, f.Name())
+		}
+		b.WriteString("\treturn nil\n}\n")
+	default:
+		fmt.Fprintf(&b, "// LogValue implements slog.LogValuer. Sensitive fields are logged as\n// %q.\n", Placeholder)
+		fmt.Fprintf(&b, "func (%s %s) LogValue() slog.Value {\n\treturn slog.GroupValue(\n", recv, recvType)
+		for _, f := range fields {
+			if sensitive(f) {
+				fmt.Fprintf(&b, "\t\tslog.String(%q, %q),\n", f.Name(), Placeholder)
+				continue
+			}
+			fmt.Fprintf(&b, "\t\tslog.Any(%q, %s.%s),\n", f.Name(), recv, f.Name())
+		}
+		b.WriteString("\t)\n}\n")
+	}
+	return b.String(), nil
+}
+
+// holdsLock reports whether a value of type t holds a lock, such as a
+// sync.Mutex, that must not be copied: a type whose pointer has Lock and
+// Unlock methods, or a struct or array containing one.
+func holdsLock(t types.Type, seen map[types.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		if _, ok := t.(*types.Named); ok && isLocker(types.NewPointer(t)) && !isLocker(t) {
+			return true
+		}
+		for i := 0; i < u.NumFields(); i++ {
+			if holdsLock(u.Field(i).Type(), seen) {
+				return true
+			}
+		}
+	case *types.Array:
+		return holdsLock(u.Elem(), seen)
+	}
+	return false
+}
+
+// isLocker reports whether t has the methods of sync.Locker.
+func isLocker(t types.Type) bool {
+	ms := types.NewMethodSet(t)
+	return ms.Lookup(nil, "Lock") != nil && ms.Lookup(nil, "Unlock") != nil
+}
+
+// receiverName derives a receiver name from a type name: its first letter,
+// lowercased.
+func receiverName(typeName string) string {
+	r, _ := utf8.DecodeRuneInString(typeName)
+	if !unicode.IsLetter(r) {
+		return "v"
+	}
+	return string(unicode.ToLower(r))
+}
@@ -0,0 +1,95 @@
+package redact
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+// lookupNamed type-checks src and returns its named type name.
+func lookupNamed(t *testing.T, src, name string) *types.Named {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "src.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Importer: importer.Default()}
+	pkg, err := conf.Check("example.com/app", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg.Scope().Lookup(name).Type().(*types.Named)
+}
+
+func byName(names ...string) func(*types.Var) bool {
+	return func(v *types.Var) bool {
+		for _, name := range names {
+			if v.Name() == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func TestMethod_Slog(t *testing.T) {
+	named := lookupNamed(t, "package app\ntype User struct { Name string; Password string }", "User")
+	got, err := Method(named, Slog, byName("Password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"func (u User) LogValue() slog.Value {",
+		`slog.Any("Name", u.Name),`,
+		`slog.String("Password", "[REDACTED]"),`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated method lacks %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestMethod_Zap(t *testing.T) {
+	named := lookupNamed(t, "package app\ntype Session struct { ID int; Token string }", "Session")
+	got, err := Method(named, Zap, byName("Token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"func (s Session) MarshalLogObject(enc zapcore.ObjectEncoder) error {",
+		`enc.AddReflected("ID", s.ID)`,
+		`enc.AddString("Token", "[REDACTED]")`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated method lacks %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestMethod_NotStruct(t *testing.T) {
+	named := lookupNamed(t, "package app\ntype Token string", "Token")
+	if _, err := Method(named, Slog, byName()); err == nil {
+		t.Error("expected an error for a non-struct type")
+	}
+}
+
+func TestMethod_BlankFieldsAndLocks(t *testing.T) {
+	named := lookupNamed(t, "package app\nimport \"sync\"\ntype Cache struct { mu sync.RWMutex; _ int; Key string }", "Cache")
+	got, err := Method(named, Slog, byName("Key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(got, "func (c *Cache) LogValue() slog.Value {") {
+		t.Errorf("expected a pointer receiver for a struct holding a lock:\n%s", got)
+	}
+	for _, unwanted := range []string{"c._", "c.mu"} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("generated method refers to %s:\n%s", unwanted, got)
+		}
+	}
+}
@@ -25,14 +25,20 @@
 //	            - cvv
 //	            - ssn
 //	            - otp
+//	        loggers:
+//	          - package: example.com/ourlog
+//	            methods: ["Infof", "Errorf"]
+//	            message_index: 1
+//	            printf: true
+//	        ssa: true
 //
 // Alternatively, point to an external .lingo.json file:
 //
 //	settings:
 //	  config: .lingo.json
 //
-// Priority: inline (filters/security keys) > config file > defaults.
-// When settings is omitted entirely, all filters are enabled with no extra keywords.
+// Priority: inline (filters/security/loggers/ssa keys) > config file > defaults.
+// When settings is omitted entirely, all filters but pii are enabled with no extra keywords.
 package main
 
 import (
@@ -49,10 +55,10 @@ import (
 // conf may be a map[string]any built from the golangci-lint settings block.
 //
 // Resolution priority:
-//  1. Inline — if "filters" or "security" keys are present, the map is parsed
+//  1. Inline — if "filters", "security", "pii", "loggers" or "ssa" keys are present, the map is parsed
 //     directly into Config (same structure as .lingo.json).
 //  2. File   — if "config" key (string) is present, the file is loaded.
-//  3. Default — all filters enabled, no extra keywords.
+//  3. Default — all filters but pii enabled, no extra keywords.
 func New(conf any) ([]*analysis.Analyzer, error) {
 	cfg, err := resolveConfig(conf)
 	if err != nil {
@@ -67,10 +73,13 @@ func resolveConfig(conf any) (*config.Config, error) {
 		return config.Default(), nil
 	}
 
-	// Inline config: filters and/or security keys present directly in settings.
+	// Inline config: filters, security, pii, loggers and/or ssa keys present directly in settings.
 	_, hasFilters := m["filters"]
 	_, hasSecurity := m["security"]
-	if hasFilters || hasSecurity {
+	_, hasPII := m["pii"]
+	_, hasLoggers := m["loggers"]
+	_, hasSSA := m["ssa"]
+	if hasFilters || hasSecurity || hasPII || hasLoggers || hasSSA {
 		cfg, err := config.FromMap(m)
 		if err != nil {
 			return nil, fmt.Errorf("lingo: parse inline settings: %w", err)
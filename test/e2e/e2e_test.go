@@ -59,6 +59,16 @@ func runVetWithConfig(t *testing.T, binary, projectDir, configPath string) (stri
 	return string(out), err
 }
 
+// runStandalone runs the lingo binary itself, which loads the packages with
+// their module information, in the given directory.
+func runStandalone(t *testing.T, binary, projectDir string) (string, error) {
+	t.Helper()
+	cmd := exec.Command(binary, "./...")
+	cmd.Dir = projectDir
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
 // absConfig returns the absolute path to the .lingo.json file inside a testdata project.
 func absConfig(t *testing.T, projectName string) string {
 	t.Helper()
@@ -106,6 +116,46 @@ func TestE2E_ViolationsProject(t *testing.T) {
 	}
 }
 
+// TestE2E_InterfacesOutsideModule verifies that a logger-shaped interface of
+// another module, such as a test reporter, is not taken for a logger, while
+// one declared in the analysed module is.
+func TestE2E_InterfacesOutsideModule(t *testing.T) {
+	binary := buildLingo(t)
+	projectDir := filepath.Join("testdata", "interfaces-project")
+
+	out, err := runStandalone(t, binary, projectDir)
+	if err == nil {
+		t.Fatal("lingo found no issues in interfaces project (expected one)")
+	}
+	if !strings.Contains(out, "main.go:11:") {
+		t.Errorf("expected the call through the module's Logger to be checked\nfull output:\n%s", out)
+	}
+	if strings.Contains(out, "main.go:12:") {
+		t.Errorf("unexpected diagnostic for the call through testkit.T\nfull output:\n%s", out)
+	}
+}
+
+// TestE2E_ConstantsOutsideModule verifies that message constants declared in
+// the analysed module are checked as text, while those of other modules, such
+// as the standard library, are not.
+func TestE2E_ConstantsOutsideModule(t *testing.T) {
+	binary := buildLingo(t)
+	projectDir := filepath.Join("testdata", "constants-project")
+
+	out, err := runStandalone(t, binary, projectDir)
+	if err == nil {
+		t.Fatal("lingo found no issues in constants project (expected one)")
+	}
+	if !strings.Contains(out, "main.go:12:") {
+		t.Errorf("expected the module's constant to be checked\nfull output:\n%s", out)
+	}
+	for _, line := range []string{"main.go:13:", "main.go:14:"} {
+		if strings.Contains(out, line) {
+			t.Errorf("unexpected diagnostic at %s for a standard library constant\nfull output:\n%s", line, out)
+		}
+	}
+}
+
 // ── Config tests ─────────────────────────────────────────────────────────────
 
 // TestE2E_Config_DisabledFilters verifies that when all filters are disabled
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"example.com/consts/msgs"
+)
+
+func main() {
+	log.Print(msgs.Starting)
+	log.Print(time.RFC1123)
+	log.Print(http.MethodGet)
+}
@@ -0,0 +1,4 @@
+// Package msgs holds message constants of the analysed module.
+package msgs
+
+const Starting = "Starting server"
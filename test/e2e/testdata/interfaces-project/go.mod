@@ -0,0 +1,7 @@
+module example.com/ifaces
+
+go 1.21
+
+require example.com/testkit v0.0.0
+
+replace example.com/testkit => ./testkit
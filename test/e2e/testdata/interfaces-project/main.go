@@ -0,0 +1,17 @@
+package main
+
+import "example.com/testkit"
+
+// Logger is declared in the analysed module, so its calls are checked.
+type Logger interface {
+	Info(msg string, kv ...any)
+}
+
+func run(l Logger, t testkit.T) {
+	l.Info("Request served")
+	t.Errorf("Expected %d items", 3)
+}
+
+func main() {
+	run(nil, nil)
+}
@@ -0,0 +1,7 @@
+// Package testkit is a module outside the analysed one with a test reporter
+// interface shaped like a printf logger.
+package testkit
+
+type T interface {
+	Errorf(format string, args ...any)
+}